@@ -12,12 +12,30 @@ type Allocator struct {
 
 // Segmentos de memoria para diferentes tipos de datos
 type AllocSegment struct {
-	Int    *Range
-	Float  *Range
-	Bool   *Range
-	String *Range
+	Int     *Range
+	Float   *Range
+	Bool    *Range
+	String  *Range
+	Pointer *Range
 }
 
+// Número de direcciones de cada rango de variables globales y locales; es
+// también el máximo de elementos de un arreglo
+const rangeSize = 1000
+
+// Índices de los rangos de un segmento por tipo de dato
+const (
+	rangeInt = iota
+	rangeFloat
+	rangeBool
+	rangeString
+	rangePointer
+	rangeCount
+)
+
+// Cantidad de direcciones reservadas de cada tipo en un segmento
+type SegmentSize [rangeCount]int
+
 // Rangos de direcciones para cada tipo de dato
 type Range struct {
 	Start int
@@ -26,25 +44,32 @@ type Range struct {
 }
 
 // Inicializa el asignador de direcciones
-func NewAllocator() {
-	alloc = &Allocator{
+func NewAllocator() *Allocator {
+	return &Allocator{
 		Global: AllocSegment{
-			Int:   &Range{Start: 1000, End: 1999, Next: 1000},
-			Float: &Range{Start: 2000, End: 2999, Next: 2000},
+			Int:    &Range{Start: 1000, End: 1999, Next: 1000},
+			Float:  &Range{Start: 2000, End: 2999, Next: 2000},
+			Bool:   &Range{Start: 10000, End: 10999, Next: 10000},
+			String: &Range{Start: 13000, End: 13999, Next: 13000},
 		},
 		Local: AllocSegment{
-			Int:   &Range{Start: 3000, End: 3999, Next: 3000},
-			Float: &Range{Start: 4000, End: 4999, Next: 4000},
+			Int:    &Range{Start: 3000, End: 3999, Next: 3000},
+			Float:  &Range{Start: 4000, End: 4999, Next: 4000},
+			Bool:   &Range{Start: 11000, End: 11999, Next: 11000},
+			String: &Range{Start: 14000, End: 14999, Next: 14000},
 		},
 		Const: AllocSegment{
 			Int:    &Range{Start: 5000, End: 5999, Next: 5000},
 			Float:  &Range{Start: 6000, End: 6999, Next: 6000},
 			String: &Range{Start: 7000, End: 7999, Next: 7000},
+			Bool:   &Range{Start: 12000, End: 12999, Next: 12000},
 		},
 		Temp: AllocSegment{
-			Int:   &Range{Start: 8000, End: 8499, Next: 8000},
-			Float: &Range{Start: 8500, End: 8999, Next: 8500},
-			Bool:  &Range{Start: 9000, End: 9499, Next: 9000},
+			Int:     &Range{Start: 8000, End: 8499, Next: 8000},
+			Float:   &Range{Start: 8500, End: 8999, Next: 8500},
+			Bool:    &Range{Start: 9000, End: 9499, Next: 9000},
+			Pointer: &Range{Start: 9500, End: 9999, Next: 9500},
+			String:  &Range{Start: 15000, End: 15999, Next: 15000},
 		},
 	}
 }
@@ -56,74 +81,76 @@ func (s *AllocSegment) Reset() {
 	if s.Bool != nil {
 		s.Bool.Next = s.Bool.Start
 	}
+	if s.String != nil {
+		s.String.Next = s.String.Start
+	}
+	if s.Pointer != nil {
+		s.Pointer.Next = s.Pointer.Start
+	}
 }
 
-// Obtiene el segmento de memoria al que pertenece una dirección
-func (a *Allocator) GetSegment(address int, frame *StackFrame) (*MemorySegment, AllocSegment) {
-	var m *MemorySegment
-	var s AllocSegment
-
-	if address >= a.Global.Int.Start && address <= a.Global.Float.End {
-		s = alloc.Global
-		m = memory.Global
-	}
-	if address >= a.Const.Int.Start && address <= a.Const.String.End {
-		s = alloc.Const
-		m = memory.Const
-	}
-	if address >= a.Local.Int.Start && address <= a.Local.Float.End {
-		s = alloc.Local
-		// Verifica el contexto actual (nil si es durante la compilación)
-		if frame != nil {
-			m = frame.Local
-		} else {
-			m = memory.Local
-		}
-	}
-	if address >= a.Temp.Int.Start && address <= a.Temp.Bool.End {
-		s = alloc.Temp
-		// Verifica el contexto actual (nil si es durante la compilación)
-		if frame != nil {
-			m = frame.Temp
-		} else {
-			m = memory.Temp
+// Obtiene los rangos del segmento ordenados por tipo de dato (nil si el
+// segmento no tiene rango para ese tipo)
+func (s AllocSegment) Ranges() [rangeCount]*Range {
+	return [rangeCount]*Range{s.Int, s.Float, s.Bool, s.String, s.Pointer}
+}
+
+// Obtiene la cantidad de direcciones reservadas de cada tipo
+func (s AllocSegment) Used() SegmentSize {
+	var used SegmentSize
+	for i, r := range s.Ranges() {
+		if r != nil {
+			used[i] = r.Next - r.Start
 		}
 	}
-
-	return m, s
+	return used
 }
 
-// Global
-func (a *Allocator) NextGlobal(typ string) (int, error) {
+// Global; reserva size direcciones contiguas y devuelve la primera
+func (a *Allocator) NextGlobal(typ string, size int) (int, error) {
 	var r *Range
 	switch typ {
 	case "int":
 		r = a.Global.Int
 	case "float":
 		r = a.Global.Float
+	case "bool":
+		r = a.Global.Bool
+	case "string":
+		r = a.Global.String
 	}
-	if r.Next > r.End {
+	if size <= 0 {
+		return -1, fmt.Errorf("tamaño inválido %d para una variable global de tipo %s", size, typ)
+	}
+	if size > r.End-r.Next+1 {
 		return -1, fmt.Errorf("espacio insuficiente para variables globales de tipo %s", typ)
 	}
 	addr := r.Next
-	r.Next++
+	r.Next += size
 	return addr, nil
 }
 
-// Local
-func (a *Allocator) NextLocal(typ string) (int, error) {
+// Local; reserva size direcciones contiguas y devuelve la primera
+func (a *Allocator) NextLocal(typ string, size int) (int, error) {
 	var r *Range
 	switch typ {
 	case "int":
 		r = a.Local.Int
 	case "float":
 		r = a.Local.Float
+	case "bool":
+		r = a.Local.Bool
+	case "string":
+		r = a.Local.String
 	}
-	if r.Next > r.End {
+	if size <= 0 {
+		return -1, fmt.Errorf("tamaño inválido %d para una variable local de tipo %s", size, typ)
+	}
+	if size > r.End-r.Next+1 {
 		return -1, fmt.Errorf("espacio insuficiente para variables locales de tipo %s", typ)
 	}
 	addr := r.Next
-	r.Next++
+	r.Next += size
 	return addr, nil
 }
 
@@ -137,6 +164,8 @@ func (a *Allocator) NextConst(typ string) (int, error) {
 		r = a.Const.Float
 	case "string":
 		r = a.Const.String
+	case "bool":
+		r = a.Const.Bool
 	}
 	if r.Next > r.End {
 		return -1, fmt.Errorf("espacio insuficiente para variables constantes de tipo %s", typ)
@@ -156,6 +185,10 @@ func (a *Allocator) NextTemp(typ string) (int, error) {
 		r = a.Temp.Float
 	case "bool":
 		r = a.Temp.Bool
+	case "string":
+		r = a.Temp.String
+	case "pointer":
+		r = a.Temp.Pointer
 	}
 	if r.Next > r.End {
 		return -1, fmt.Errorf("espacio insuficiente para variables temporales de tipo %s", typ)
@@ -164,3 +197,37 @@ func (a *Allocator) NextTemp(typ string) (int, error) {
 	r.Next++
 	return addr, nil
 }
+
+// Obtiene el tipo de dato de una dirección de cualquier segmento ("" si no
+// pertenece a ningún rango)
+func (a *Allocator) TypeOf(addr int) string {
+	for _, seg := range []AllocSegment{a.Global, a.Local, a.Const, a.Temp} {
+		if kind, ok := seg.kindOf(addr); ok {
+			return kindNames[kind]
+		}
+	}
+	return ""
+}
+
+// Indica si una dirección pertenece al segmento de temporales
+func (a *Allocator) IsTemp(addr int) bool {
+	_, ok := a.Temp.kindOf(addr)
+	return ok
+}
+
+// Indica si una dirección pertenece al segmento global, que incluye los
+// valores de retorno de las funciones
+func (a *Allocator) IsGlobal(addr int) bool {
+	_, ok := a.Global.kindOf(addr)
+	return ok
+}
+
+// Obtiene el índice del rango del segmento que contiene una dirección
+func (s AllocSegment) kindOf(addr int) (int, bool) {
+	for kind, r := range s.Ranges() {
+		if r != nil && addr >= r.Start && addr <= r.End {
+			return kind, true
+		}
+	}
+	return 0, false
+}
@@ -1,168 +1,188 @@
 package ast
 
-import "fmt"
-
-var scope string
-var global string
+import (
+	"BabyDuck/token"
+	"errors"
+	"fmt"
+)
+
+// Crea un compilador con su propia memoria y directorio de funciones
+func NewCompiler() *Compiler {
+	return &Compiler{
+		FuncDir: map[string]*FuncNode{},
+		Memory:  NewMemory(),
+		Alloc:   NewAllocator(),
+	}
+}
 
-func ValidateVars(vars []*VarNode) error {
+// Devuelve un error por cada variable declarada más de una vez
+func ValidateVars(vars []*VarNode) []error {
 	tempVars := make(map[string]bool)
+	var errs []error
 
 	for _, v := range vars {
 		// Verificar si la variable ya existe en el ámbito actual
 		if _, exists := tempVars[v.Id]; exists {
-			return fmt.Errorf("variable '%s' ya declarada en el ámbito actual", v.Id)
+			errs = append(errs, newDiagnostic(CodeDuplicateVar, v.Pos, "variable '%s' ya declarada en el ámbito actual", v.Id))
 		}
 		// Agregar la variable al mapa temporal para validación
 		tempVars[v.Id] = true
 	}
 
-	return nil
+	return errs
 }
 
-func DeclareFunction(typ, id string, params, vars []*VarNode, body []Attrib) (*FuncNode, error) {
-	// Verificar si la función ya existe
-	if _, exists := funcDir[id]; exists {
-		return nil, fmt.Errorf("función '%s' ya declarada", id)
-	}
-
-	// Crear el nodo de función
-	funcNode := &FuncNode{
-		Id:         id,
-		Params:     params,
-		Vars:       vars,
-		Body:       body,
-		ReturnType: typ,
-	}
-
-	// Agregar la función al directorio
-	funcDir[id] = funcNode
+// Devuelve un error por cada arreglo con dimensiones inválidas
+func ValidateDims(vars []*VarNode) []error {
+	var errs []error
 
-	// Verificar si hay variables duplicadas
-	if err := ValidateVars(append(params, vars...)); err != nil {
-		return nil, err
+	for _, v := range vars {
+		// Verificar el número de dimensiones
+		if len(v.Dims) > 2 {
+			errs = append(errs, newDiagnostic(CodeIndex, v.Pos, "el arreglo '%s' tiene %d dimensiones; el máximo es 2", v.Id, len(v.Dims)))
+			continue
+		}
+		// Verificar el tamaño de cada dimensión y que el arreglo completo quepa
+		// en un segmento; el límite se revisa antes de multiplicar para que el
+		// producto de las dimensiones no desborde
+		size := 1
+		for _, dim := range v.Dims {
+			if dim <= 0 {
+				errs = append(errs, newDiagnostic(CodeIndex, v.Pos, "el arreglo '%s' debe tener un tamaño mayor a cero", v.Id))
+				break
+			}
+			if dim > rangeSize/size {
+				errs = append(errs, newDiagnostic(CodeIndex, v.Pos, "el arreglo '%s' excede el máximo de %d elementos", v.Id, rangeSize))
+				break
+			}
+			size *= dim
+		}
 	}
 
-	return funcNode, nil
+	return errs
 }
 
-func DeclareVariable(varNode *VarNode) error {
+func (c *Compiler) DeclareVariable(varNode *VarNode) error {
 	// Obtener la dirección de memoria para la variable
 	var addr int
 	var err error
 
 	if varNode.Id == "" {
-		addr, err = alloc.NextConst(varNode.Type)
-	} else if scope == global {
-		addr, err = alloc.NextGlobal(varNode.Type)
+		addr, err = c.Alloc.NextConst(varNode.Type)
+	} else if c.Scope == c.Global {
+		addr, err = c.Alloc.NextGlobal(varNode.Type, varNode.Size())
 	} else {
-		addr, err = alloc.NextLocal(varNode.Type)
+		addr, err = c.Alloc.NextLocal(varNode.Type, varNode.Size())
 	}
 	if err != nil {
-		return err
+		return errorAt(CodeMemory, varNode.Pos, err)
 	}
 
 	// Actualizar el nodo de variable con la dirección
 	varNode.Address = addr
 
-	// Insertar la variable en la memoria correspondiente
-	if varNode.Id == "" {
-		memory.Const.Insert(varNode)
-	} else if scope == global {
-		memory.Global.Insert(varNode)
-	} else {
-		memory.Local.Insert(varNode)
+	// Insertar la variable y los demás elementos si es un arreglo en la
+	// memoria correspondiente
+	nodes := append([]*VarNode{varNode}, varNode.ElementNodes()...)
+	for _, node := range nodes {
+		if varNode.Id == "" {
+			c.Memory.Const.Insert(node)
+		} else if c.Scope == c.Global {
+			c.Memory.Global.Insert(node)
+		} else {
+			c.Memory.Local.Insert(node)
+		}
 	}
 
 	return nil
 }
 
-func (n ProgramNode) Generate(ct *Compilation) error {
-	// Inicializar la memoria y el asignador de direcciones
-	NewMemory()
-	NewAllocator()
+func (n *ProgramNode) Generate(ct *Compilation) error {
+	// Verificar la semántica antes de generar el código intermedio
+	if err := n.Check(NewChecker(ct.Diags)); err != nil {
+		return err
+	}
+
+	// Solo la falta de memoria puede fallar durante la generación
+	if err := n.generate(ct); err != nil {
+		var diag *Diagnostic
+		if !errors.As(err, &diag) {
+			return err
+		}
+		ct.Diags.Add(diag)
+		return ct.Diags.Err()
+	}
+
+	return nil
+}
+
+func (n *ProgramNode) generate(ct *Compilation) error {
+	c := ct.Compiler
 
 	// Establecer el ámbito global
-	global = n.Id
-	scope = global
+	c.Global = n.Id
+	c.Scope = c.Global
 
 	// Registrar el programa en el directorio de funciones
-	funcDir[n.Id] = &FuncNode{
+	c.FuncDir[n.Id] = &FuncNode{
 		Id:         n.Id,
 		ReturnType: "void",
 	}
 
-	// Verificar si hay variables duplicadas
-	if err := ValidateVars(n.Vars); err != nil {
-		return err
+	// Registrar las funciones antes de generar su código para permitir
+	// llamadas entre ellas sin importar el orden de declaración
+	for _, funcNode := range n.Funcs {
+		c.FuncDir[funcNode.Id] = funcNode
 	}
 
 	// Agregar el cuádruplo de inicio del programa
-	ct.AddQuad(GOTO, -1, -1, -1)
+	ct.AddQuad(GOTO, -1, -1, -1, n.Pos)
 
 	// Crear variables dentro del ámbito global
 	for _, v := range n.Vars {
-		if err := DeclareVariable(v); err != nil {
-			return fmt.Errorf("error de compilación en '%s': %v", v.Id, err)
+		if err := c.DeclareVariable(v); err != nil {
+			return err
 		}
 	}
 
 	// Generar cuádruplos para las funciones
 	for _, funcNode := range n.Funcs {
 		if err := funcNode.Generate(ct); err != nil {
-			return fmt.Errorf("error de compilación en '%s': %v", funcNode.Id, err)
+			return err
 		}
 	}
 
 	// Marcar el inicio del programa
 	ct.Quads[0].Result = len(ct.Quads)
+	mainNode := c.FuncDir[n.Id]
+	mainNode.QuadStart = len(ct.Quads)
 
 	// Generar cuádruplos para el cuerpo del programa
 	for _, stmt := range n.Body {
 		if err := stmt.Generate(ct); err != nil {
-			return fmt.Errorf("error de compilación en '%s': %v", n.Id, err)
+			return err
 		}
 	}
 
-	// Imprimir variables globales y constantes
-	fmt.Println()
-	fmt.Printf("Programa: %s\n", n.Id)
-	fmt.Println("===================================")
-
-	if memory.Global.Size() > 0 {
-		fmt.Println()
-		fmt.Println("Globales:")
-		fmt.Println("===================================")
-		memory.Global.Print()
-	}
-
-	if memory.Const.Size() > 0 {
-		fmt.Println()
-		fmt.Println("Constantes:")
-		fmt.Println("===================================")
-		memory.Const.Print()
-	}
-
-	if memory.Temp.Size() > 0 {
-		fmt.Println()
-		fmt.Println("Temporales:")
-		fmt.Println("===================================")
-		memory.Temp.Print()
-	}
+	// Completar las llamadas a funciones declaradas después de quien llama
+	ct.patchCalls()
 
-	// Imprimir cuádruplos generados
-	ct.PrintQuads()
+	// Guardar los temporales y el tamaño del contexto del cuerpo principal
+	mainNode.Temps = c.Memory.Temp.GetAll()
+	mainNode.TempSize = c.Alloc.Temp.Used()
 
 	return nil
 }
 
 func (n *FuncNode) Generate(ct *Compilation) error {
+	c := ct.Compiler
+
 	// Reservar una dirección de memoria para el retorno si la función no es void
 	if n.ReturnType != "void" {
 		// Obtener una dirección de memoria para el retorno
-		addr, err := alloc.NextGlobal(n.ReturnType)
+		addr, err := c.Alloc.NextGlobal(n.ReturnType, 1)
 		if err != nil {
-			return err
+			return errorAt(CodeMemory, n.Pos, err)
 		}
 
 		// Actualizar el nodo de función con la dirección de retorno
@@ -176,66 +196,41 @@ func (n *FuncNode) Generate(ct *Compilation) error {
 		}
 
 		// Insertar en la memoria
-		memory.Global.Insert(returnNode)
+		c.Memory.Global.Insert(returnNode)
 	}
 
 	// Marcar el inicio del cuádruplo de la función
-	funcDir[n.Id].QuadStart = len(ct.Quads)
+	n.QuadStart = len(ct.Quads)
 
 	// Establecer el ámbito actual a la función
-	scope = n.Id
+	c.Scope = n.Id
 
-	// Crear parámetros dentro del ámbito de la función
-	var paramNodes []*VarNode
+	// Crear parámetros y variables dentro del ámbito de la función
 	for _, p := range n.Params {
-		if err := DeclareVariable(p); err != nil {
-			return fmt.Errorf("error al declarar parámetro '%s' en función '%s': %v", p.Id, n.Id, err)
+		if err := c.DeclareVariable(p); err != nil {
+			return err
 		}
-		paramNodes = append(paramNodes, p)
 	}
-
-	// Crear variables dentro del ámbito de la función
-	var varNodes []*VarNode
 	for _, v := range n.Vars {
-		if err := DeclareVariable(v); err != nil {
-			return fmt.Errorf("error al declarar variable '%s' en función '%s': %v", v.Id, n.Id, err)
+		if err := c.DeclareVariable(v); err != nil {
+			return err
 		}
-		varNodes = append(varNodes, v)
 	}
 
 	// Generar cuádruplos para el cuerpo de la función
 	for _, stmt := range n.Body {
 		if err := stmt.Generate(ct); err != nil {
-			return fmt.Errorf("error al generar cuádruplos para '%s': %v", n.Id, err)
+			return err
 		}
 	}
 
 	// Agregar el cuádruplo de retorno al final de la función
-	ct.AddQuad(ENDFUNC, -1, -1, -1)
-
-	// Guardar variables generadas en la función
-	funcDir[n.Id].Params = paramNodes
-	funcDir[n.Id].Vars = varNodes
-	funcDir[n.Id].Temps = memory.Temp.GetAll()
+	ct.AddQuad(ENDFUNC, -1, -1, -1, n.Pos)
 
-	// Imprimir variables locales y temporales de la función
-	fmt.Println()
-	fmt.Printf("Función: %s\n", n.Id)
-	fmt.Println("===================================")
-
-	if memory.Local.Size() > 0 {
-		fmt.Println()
-		fmt.Println("Locales:")
-		fmt.Println("===================================")
-		memory.Local.Print()
-	}
-
-	if memory.Temp.Size() > 0 {
-		fmt.Println()
-		fmt.Println("Temporales:")
-		fmt.Println("===================================")
-		memory.Temp.Print()
-	}
+	// Guardar los temporales generados y el tamaño del contexto de la función
+	n.Temps = c.Memory.Temp.GetAll()
+	n.LocalSize = c.Alloc.Local.Used()
+	n.TempSize = c.Alloc.Temp.Used()
 
 	// Limpiar el ámbito local
 	ct.ClearLocalScope()
@@ -244,12 +239,14 @@ func (n *FuncNode) Generate(ct *Compilation) error {
 }
 
 func (n *VarNode) Generate(ct *Compilation) error {
+	c := ct.Compiler
+
 	// Buscar la constante en la memoria
-	varNode, found := memory.Const.FindConst(n.Type, n.Value)
+	varNode, found := c.Memory.Const.FindConst(n.Type, n.Value)
 
 	if !found {
 		// Declarar la constante si no existe
-		if err := DeclareVariable(n); err != nil {
+		if err := c.DeclareVariable(n); err != nil {
 			return err
 		}
 		// Agregar la dirección a la pila
@@ -262,19 +259,15 @@ func (n *VarNode) Generate(ct *Compilation) error {
 	return nil
 }
 
-func (n AssignNode) Generate(ct *Compilation) error {
-	// Buscar variable destino y memoria correcta
-	var destNode *VarNode
-	var found bool
-
-	if scope != global {
-		destNode, found = memory.Local.FindByName(n.Id)
-	}
-	if !found {
-		destNode, found = memory.Global.FindByName(n.Id)
-	}
-	if !found {
-		return fmt.Errorf("variable '%s' no declarada", n.Id)
+func (n *AssignNode) Generate(ct *Compilation) error {
+	// Obtener la dirección destino, calculándola si es un elemento de arreglo
+	dest := n.Symbol.Address
+	if len(n.Indices) > 0 {
+		addr, err := ct.generateAddress(n.Symbol, n.Indices, n.Pos)
+		if err != nil {
+			return err
+		}
+		dest = addr
 	}
 
 	// Generar el código intermedio para la expresión
@@ -283,25 +276,13 @@ func (n AssignNode) Generate(ct *Compilation) error {
 	}
 	result := ct.Pop()
 
-	// Obtener el nodo de resultado desde memoria
-	resultNode, err := GetByAddress(result, nil)
-	if err != nil {
-		return err
-	}
-
-	// Verificar que el tipo del resultado sea compatible con el tipo de la variable destino
-	_, err = CheckSemantic(ASSIGN, resultNode.Type, destNode.Type)
-	if err != nil {
-		return err
-	}
-
-	// Agregar el cuádruplo de asignación
-	ct.AddQuad(ASSIGN, result, -1, destNode.Address)
+	// Agregar el cuádruplo de asignación a la variable destino
+	ct.AddQuad(ASSIGN, result, -1, dest, n.Pos)
 
 	return nil
 }
 
-func (n PrintNode) Generate(ct *Compilation) error {
+func (n *PrintNode) Generate(ct *Compilation) error {
 	// Generar el código intermedio para los elementos a imprimir
 	for _, item := range n.Items {
 		if err := item.Generate(ct); err != nil {
@@ -310,16 +291,52 @@ func (n PrintNode) Generate(ct *Compilation) error {
 		result := ct.Pop()
 
 		// Agregar el cuádruplo de impresión
-		ct.AddQuad(PRINT, result, -1, -1)
+		ct.AddQuad(PRINT, result, -1, -1, n.Pos)
 	}
 
 	// Agregar el cuádruplo de nueva línea
-	ct.AddQuad(PRINTLN, -1, -1, -1)
+	ct.AddQuad(PRINTLN, -1, -1, -1, n.Pos)
+
+	return nil
+}
+
+func (n *ReadNode) Generate(ct *Compilation) error {
+	for _, v := range n.Vars {
+		// Obtener la dirección destino, calculándola si es un elemento de arreglo
+		if err := v.Generate(ct); err != nil {
+			return err
+		}
+		dest := ct.Pop()
+
+		// Agregar el cuádruplo de lectura
+		ct.AddQuad(READ, -1, -1, dest, v.Pos)
+	}
 
 	return nil
 }
 
-func (n ExpressionNode) Generate(ct *Compilation) error {
+func (n *ExpressionNode) Generate(ct *Compilation) error {
+	// Los operadores lógicos binarios se evalúan en corto circuito
+	if n.Op == AND || n.Op == OR {
+		return n.generateShortCircuit(ct)
+	}
+
+	// Generar el código intermedio para el operador unario
+	if n.Right == nil {
+		if err := n.Left.Generate(ct); err != nil {
+			return err
+		}
+		left := ct.Pop()
+
+		addr, err := ct.newTempVar(n.Type, n.Pos)
+		if err != nil {
+			return err
+		}
+		ct.AddQuad(n.Op, left, -1, addr, n.Pos)
+		ct.Push(addr)
+		return nil
+	}
+
 	// Generar el código intermedio para los operandos izquierdo y derecho
 	if err := n.Left.Generate(ct); err != nil {
 		return err
@@ -332,44 +349,59 @@ func (n ExpressionNode) Generate(ct *Compilation) error {
 	right := ct.Pop()
 	left := ct.Pop()
 
-	// Obtener los nodos de memoria correspondientes
-	leftNode, err := GetByAddress(left, nil)
-	if err != nil {
-		return err
-	}
-	rightNode, err := GetByAddress(right, nil)
+	// Reservar un temporal del tipo resuelto por el verificador
+	addr, err := ct.newTempVar(n.Type, n.Pos)
 	if err != nil {
 		return err
 	}
 
-	if n.Op == DIVIDE && rightNode.Value == "0" {
-		return fmt.Errorf("división por cero en la expresión")
-	}
+	// Agregar el cuádruplo de la operación
+	ct.AddQuad(n.Op, left, right, addr, n.Pos)
 
-	// Verificar la compatibilidad de tipos
-	resultType, err := CheckSemantic(n.Op, leftNode.Type, rightNode.Type)
-	if err != nil {
+	// Agregar el temporal a la pila
+	ct.Push(addr)
+
+	return nil
+}
+
+// Genera una conjunción o disyunción que solo evalúa el operando derecho
+// si el izquierdo no determina el resultado
+func (n *ExpressionNode) generateShortCircuit(ct *Compilation) error {
+	// Guardar el operando izquierdo en el temporal del resultado
+	if err := n.Left.Generate(ct); err != nil {
 		return err
 	}
+	left := ct.Pop()
 
-	// Obtener la dirección de memoria para el temporal
-	addr, err := alloc.NextTemp(resultType)
+	addr, err := ct.newTempVar(n.Type, n.Pos)
 	if err != nil {
 		return err
 	}
+	ct.AddQuad(ASSIGN, left, -1, addr, n.Pos)
 
-	// Crear un nuevo nodo temporal
-	tempNode := &VarNode{
-		Address: addr,
-		Id:      ct.NewTemp(),
-		Type:    resultType,
+	// Saltar el operando derecho si el izquierdo es falso (and) o verdadero (or)
+	indexGOTOF := len(ct.Quads)
+	ct.AddQuad(GOTOF, addr, -1, -1, n.Pos)
+	indexGOTO := -1
+	if n.Op == OR {
+		indexGOTO = len(ct.Quads)
+		ct.AddQuad(GOTO, -1, -1, -1, n.Pos)
+		ct.Quads[indexGOTOF].Result = len(ct.Quads)
 	}
 
-	// Insertar el temporal en la memoria
-	memory.Temp.Insert(tempNode)
+	// Evaluar el operando derecho y guardarlo como resultado
+	if err := n.Right.Generate(ct); err != nil {
+		return err
+	}
+	right := ct.Pop()
+	ct.AddQuad(ASSIGN, right, -1, addr, n.Pos)
 
-	// Agregar el cuádruplo de la operación
-	ct.AddQuad(n.Op, left, right, addr)
+	// Marcar la salida del corto circuito
+	if n.Op == OR {
+		ct.Quads[indexGOTO].Result = len(ct.Quads)
+	} else {
+		ct.Quads[indexGOTOF].Result = len(ct.Quads)
+	}
 
 	// Agregar el temporal a la pila
 	ct.Push(addr)
@@ -377,43 +409,33 @@ func (n ExpressionNode) Generate(ct *Compilation) error {
 	return nil
 }
 
-func (n ExpressionVar) Generate(ct *Compilation) error {
-	// Buscar en la memoria local o global
-	var varNode *VarNode
-	var found bool
-
-	if scope != global {
-		varNode, found = memory.Local.FindByName(n.Id)
-	}
-	if !found {
-		varNode, found = memory.Global.FindByName(n.Id)
-	}
-	if !found {
-		return fmt.Errorf("variable '%s' no declarada", n.Id)
+func (n *ExpressionVar) Generate(ct *Compilation) error {
+	// Calcular la dirección del elemento si es un arreglo
+	if len(n.Indices) > 0 {
+		addr, err := ct.generateAddress(n.Symbol, n.Indices, n.Pos)
+		if err != nil {
+			return err
+		}
+		ct.Push(addr)
+		return nil
 	}
 
-	// Agregar la direción a la pila
-	ct.Push(varNode.Address)
+	// Agregar la dirección de la variable resuelta a la pila
+	ct.Push(n.Symbol.Address)
 
 	return nil
 }
 
-func (n IfNode) Generate(ct *Compilation) error {
+func (n *IfNode) Generate(ct *Compilation) error {
 	// Generar el código intermedio para la condición
 	if err := n.Condition.Generate(ct); err != nil {
 		return err
 	}
 	result := ct.Pop()
 
-	// Buscar el tipo del resultado de la condición
-	resultNode, _ := GetByAddress(result, nil)
-	if resultNode.Type != "bool" {
-		return fmt.Errorf("tipo incompatible en condición if: se esperaba bool, se obtuvo %s", resultNode.Type)
-	}
-
 	// Agregar el cuádruplo GOTOF
 	indexGOTOF := len(ct.Quads)
-	ct.AddQuad(GOTOF, result, -1, -1)
+	ct.AddQuad(GOTOF, result, -1, -1, n.Pos)
 
 	// Generar los cuádruplos para el bloque Then
 	for _, stmt := range n.ThenBlock {
@@ -424,7 +446,7 @@ func (n IfNode) Generate(ct *Compilation) error {
 
 	// Agregar el cuádruplo GOTO
 	indexGOTO := len(ct.Quads)
-	ct.AddQuad(GOTO, -1, -1, -1)
+	ct.AddQuad(GOTO, -1, -1, -1, n.Pos)
 
 	// Marcar la etiqueta para el cuádruplo GOTOF
 	ct.Quads[indexGOTOF].Result = len(ct.Quads)
@@ -442,7 +464,60 @@ func (n IfNode) Generate(ct *Compilation) error {
 	return nil
 }
 
-func (n WhileNode) Generate(ct *Compilation) error {
+func (n *SwitchNode) Generate(ct *Compilation) error {
+	// Generar el código intermedio para la expresión; se evalúa una sola vez
+	if err := n.Exp.Generate(ct); err != nil {
+		return err
+	}
+	value := ct.Pop()
+
+	// Comparar la expresión con cada caso en orden
+	var exits []int
+	for _, c := range n.Cases {
+		if err := c.Value.Generate(ct); err != nil {
+			return err
+		}
+		cond, err := ct.newTempVar("bool", c.Pos)
+		if err != nil {
+			return err
+		}
+		ct.AddQuad(EQ, value, ct.Pop(), cond, c.Pos)
+
+		// Agregar el cuádruplo GOTOF hacia la siguiente comparación
+		indexGOTOF := len(ct.Quads)
+		ct.AddQuad(GOTOF, cond, -1, -1, c.Pos)
+
+		// Generar los cuádruplos para el cuerpo del caso
+		for _, stmt := range c.Body {
+			if err := stmt.Generate(ct); err != nil {
+				return err
+			}
+		}
+
+		// Agregar el cuádruplo GOTO hacia el final del switch
+		exits = append(exits, len(ct.Quads))
+		ct.AddQuad(GOTO, -1, -1, -1, c.Pos)
+
+		// Marcar la etiqueta para el cuádruplo GOTOF
+		ct.Quads[indexGOTOF].Result = len(ct.Quads)
+	}
+
+	// Generar los cuádruplos para el caso default
+	for _, stmt := range n.Default {
+		if err := stmt.Generate(ct); err != nil {
+			return err
+		}
+	}
+
+	// Marcar la etiqueta para los cuádruplos GOTO
+	for _, index := range exits {
+		ct.Quads[index].Result = len(ct.Quads)
+	}
+
+	return nil
+}
+
+func (n *WhileNode) Generate(ct *Compilation) error {
 	// Marcar el inicio del ciclo
 	start := len(ct.Quads)
 
@@ -452,125 +527,283 @@ func (n WhileNode) Generate(ct *Compilation) error {
 	}
 	result := ct.Pop()
 
-	// Buscar el tipo del resultado de la condición
-	resultNode, _ := GetByAddress(result, nil)
-	if resultNode.Type != "bool" {
-		return fmt.Errorf("tipo incompatible en condición if: se esperaba bool, se obtuvo %s", resultNode.Type)
+	// Agregar el cuádruplo GOTOF
+	indexGOTOF := len(ct.Quads)
+	ct.AddQuad(GOTOF, result, -1, -1, n.Pos)
+
+	// Generar los cuádruplos para el cuerpo del ciclo
+	ct.pushLoop()
+	for _, stmt := range n.Body {
+		if err := stmt.Generate(ct); err != nil {
+			return err
+		}
+	}
+
+	// Agregar el cuádruplo GOTO
+	ct.AddQuad(GOTO, -1, -1, start, n.Pos)
+
+	// Marcar la etiqueta para el cuádruplo GOTOF y los saltos del cuerpo;
+	// continue vuelve a evaluar la condición
+	ct.Quads[indexGOTOF].Result = len(ct.Quads)
+	ct.popLoop(start, len(ct.Quads))
+
+	return nil
+}
+
+func (n *ForNode) Generate(ct *Compilation) error {
+	control := n.Symbol.Address
+	varType := n.Symbol.Type
+
+	// Asignar el valor inicial a la variable de control
+	if err := n.Start.Generate(ct); err != nil {
+		return err
+	}
+	ct.AddQuad(ASSIGN, ct.Pop(), -1, control, n.Pos)
+
+	// Evaluar el límite y el incremento una sola vez
+	end, err := ct.generateOnce(n.End, n.Pos)
+	if err != nil {
+		return err
+	}
+	step := n.Step
+	if step == nil {
+		// El incremento omitido es 1 del tipo de la variable de control
+		one := &VarNode{Type: "int", Value: IntValue(1), Pos: n.Pos}
+		if varType == "float" {
+			one = &VarNode{Type: "float", Value: FloatValue(1), Pos: n.Pos}
+		}
+		step = one
+	}
+	inc, err := ct.generateOnce(step, n.Pos)
+	if err != nil {
+		return err
+	}
+
+	// Si el signo del incremento se conoce al compilar, el ciclo usa una sola
+	// comparación; si no, se elige la comparación con el signo en ejecución
+	cond, err := ct.newTempVar("bool", n.Pos)
+	if err != nil {
+		return err
+	}
+	var start int
+	if cte, ok := step.(*VarNode); ok {
+		op := LTE
+		if cte.Value.AsFloat() < 0 {
+			op = GTE
+		}
+		start = len(ct.Quads)
+		ct.AddQuad(op, control, end, cond, n.Pos)
+	} else {
+		// Calcular si el incremento es positivo antes de entrar al ciclo
+		zero := &VarNode{Type: "int", Value: IntValue(0), Pos: n.Pos}
+		if err := zero.Generate(ct); err != nil {
+			return err
+		}
+		ascending, err := ct.newTempVar("bool", n.Pos)
+		if err != nil {
+			return err
+		}
+		ct.AddQuad(GTE, inc, ct.Pop(), ascending, n.Pos)
+
+		// Comparar con <= si el incremento es positivo y con >= si es negativo
+		start = len(ct.Quads)
+		ct.AddQuad(GOTOF, ascending, -1, start+3, n.Pos)
+		ct.AddQuad(LTE, control, end, cond, n.Pos)
+		ct.AddQuad(GOTO, -1, -1, start+4, n.Pos)
+		ct.AddQuad(GTE, control, end, cond, n.Pos)
 	}
 
 	// Agregar el cuádruplo GOTOF
 	indexGOTOF := len(ct.Quads)
-	ct.AddQuad(GOTOF, result, -1, -1)
+	ct.AddQuad(GOTOF, cond, -1, -1, n.Pos)
 
 	// Generar los cuádruplos para el cuerpo del ciclo
+	ct.pushLoop()
 	for _, stmt := range n.Body {
 		if err := stmt.Generate(ct); err != nil {
 			return err
 		}
 	}
 
-	// Agregar el cuádruplo GOTO
-	ct.AddQuad(GOTO, -1, -1, start)
+	// Incrementar la variable de control y regresar a la comparación
+	increment := len(ct.Quads)
+	next, err := ct.newTempVar(varType, n.Pos)
+	if err != nil {
+		return err
+	}
+	ct.AddQuad(PLUS, control, inc, next, n.Pos)
+	ct.AddQuad(ASSIGN, next, -1, control, n.Pos)
+	ct.AddQuad(GOTO, -1, -1, start, n.Pos)
 
-	// Marcar la etiqueta para el cuádruplo GOTOF
+	// Marcar la etiqueta para el cuádruplo GOTOF y los saltos del cuerpo;
+	// continue pasa al incremento
 	ct.Quads[indexGOTOF].Result = len(ct.Quads)
+	ct.popLoop(increment, len(ct.Quads))
 
 	return nil
 }
 
-func (n FCallNode) Generate(ct *Compilation) error {
-	// Buscar la función en el directorio de funciones
-	funcNode, found := funcDir[n.Id]
-	if !found {
-		return fmt.Errorf("función '%s' no declarada", n.Id)
+// Genera una expresión que se evalúa una sola vez; si es una variable, su
+// valor se copia a un temporal para que el cuerpo de un ciclo no lo altere
+func (ct *Compilation) generateOnce(exp Attrib, pos token.Pos) (int, error) {
+	if err := exp.Generate(ct); err != nil {
+		return 0, err
 	}
-	if funcNode.Id == global {
-		return fmt.Errorf("no se puede llamar a la función '%s'", n.Id)
+	result := ct.Pop()
+	if _, ok := exp.(*ExpressionVar); !ok {
+		return result, nil
 	}
 
-	// Verificar el número de parámetros
-	if len(n.Params) != len(funcNode.Params) {
-		return fmt.Errorf("número de parámetros incorrecto para la función '%s': se esperaban %d, se recibieron %d", n.Id, len(funcNode.Params), len(n.Params))
+	addr, err := ct.newTempVar(TypeOf(exp), pos)
+	if err != nil {
+		return 0, err
 	}
+	ct.AddQuad(ASSIGN, result, -1, addr, pos)
+	return addr, nil
+}
+
+func (n *BreakNode) Generate(ct *Compilation) error {
+	// Agregar un GOTO que se completa al terminar el ciclo
+	loop := ct.loops[len(ct.loops)-1]
+	loop.breaks = append(loop.breaks, len(ct.Quads))
+	ct.AddQuad(GOTO, -1, -1, -1, n.Pos)
+	return nil
+}
+
+func (n *ContinueNode) Generate(ct *Compilation) error {
+	// Agregar un GOTO que se completa al terminar el ciclo
+	loop := ct.loops[len(ct.loops)-1]
+	loop.continues = append(loop.continues, len(ct.Quads))
+	ct.AddQuad(GOTO, -1, -1, -1, n.Pos)
+	return nil
+}
+
+func (n *FCallNode) Generate(ct *Compilation) error {
+	// Función resuelta por el verificador
+	funcNode := n.Func
+
+	// Registrar la llamada para completarla al generar todas las funciones,
+	// ya que la función puede declararse después
+	call := callSite{Func: funcNode, Era: len(ct.Quads), Assign: -1}
 
 	// Agregar el cuádruplo de ERA (Reservar Espacio de Registro)
-	ct.AddQuad(ERA, funcNode.QuadStart, -1, -1)
+	ct.AddQuad(ERA, funcNode.QuadStart, -1, -1, n.Pos)
 
 	// Generar el código intermedio para los parámetros
 	for i, param := range n.Params {
 		if err := param.Generate(ct); err != nil {
-			return fmt.Errorf("error al generar parámetro en llamada a función '%s': %v", n.Id, err)
+			return err
 		}
 		result := ct.Pop()
 
-		// Verificar el tipo del parámetro
-		resultNode, _ := GetByAddress(result, nil)
-		if resultNode.Type != funcNode.Params[i].Type {
-			return fmt.Errorf("tipo de parámetro incorrecto en la función '%s': se esperaba %s, se recibió %s", n.Id, funcNode.Params[i].Type, resultNode.Type)
-		}
-
 		// Agregar el cuádruplo de asignación de parámetro
-		ct.AddQuad(PARAM, result, -1, i+1)
+		ct.AddQuad(PARAM, result, -1, i+1, n.Pos)
 	}
 
 	// Agregar el cuádruplo de llamada a función
-	ct.AddQuad(GOSUB, funcNode.QuadStart, -1, -1)
+	call.Gosub = len(ct.Quads)
+	ct.AddQuad(GOSUB, funcNode.QuadStart, -1, -1, n.Pos)
 
 	if funcNode.ReturnType != "void" {
 		// Reservar una dirección temporal para el retorno
-		addr, err := alloc.NextTemp(funcNode.ReturnType)
+		addr, err := ct.newTempVar(funcNode.ReturnType, n.Pos)
 		if err != nil {
 			return err
 		}
 
-		// Crear un nodo temporal para almacenar el retorno
-		tempNode := &VarNode{
-			Address: addr,
-			Id:      ct.NewTemp(),
-			Type:    funcNode.ReturnType,
-		}
-
-		// Insertar el nodo en la memoria temporal
-		memory.Temp.Insert(tempNode)
-
 		// Agregar el cuádruplo de asignación del temporal
-		ct.AddQuad(ASSIGN, funcNode.ReturnAddress, -1, addr)
+		call.Assign = len(ct.Quads)
+		ct.AddQuad(ASSIGN, funcNode.ReturnAddress, -1, addr, n.Pos)
 
 		// Empujar el temporal a la pila semántica
 		ct.Push(addr)
 	}
+	ct.calls = append(ct.calls, call)
 
 	return nil
 }
 
-func (n ReturnNode) Generate(ct *Compilation) error {
-	// Verificar si la función tiene un tipo de retorno
-	funcNode := funcDir[scope]
-	if funcNode.ReturnType == "void" {
-		return fmt.Errorf("la función '%s' es de tipo void", scope)
-	}
-
+func (n *ReturnNode) Generate(ct *Compilation) error {
 	// Generar el código intermedio para el valor de retorno
 	if err := n.Exp.Generate(ct); err != nil {
 		return err
 	}
 	result := ct.Pop()
 
-	// Obtener el nodo de resultado desde memoria
-	resultNode, err := GetByAddress(result, nil)
+	// Agregar los cuádruplo de retorno
+	ct.AddQuad(RETURN, result, -1, -1, n.Pos)
+	ct.AddQuad(ENDFUNC, -1, -1, -1, n.Pos)
+
+	return nil
+}
+
+// Reserva un temporal del tipo indicado y lo inserta en la memoria
+func (ct *Compilation) newTempVar(typ string, pos token.Pos) (int, error) {
+	c := ct.Compiler
+
+	// Obtener la dirección de memoria para el temporal
+	addr, err := c.Alloc.NextTemp(typ)
 	if err != nil {
-		return err
+		return 0, errorAt(CodeMemory, pos, err)
 	}
 
-	// Verificar que el tipo del resultado sea compatible con el tipo de retorno de la función
-	_, err = CheckSemantic(RETURN, resultNode.Type, funcNode.ReturnType)
-	if err != nil {
-		return err
+	// Crear un nuevo nodo temporal e insertarlo en la memoria
+	c.Memory.Temp.Insert(&VarNode{
+		Address: addr,
+		Id:      ct.NewTemp(),
+		Type:    typ,
+	})
+
+	return addr, nil
+}
+
+// Genera los cuádruplos que verifican los índices de un elemento de arreglo
+// y calculan su dirección; devuelve el apuntador temporal al elemento
+func (ct *Compilation) generateAddress(array *VarNode, indices []Attrib, pos token.Pos) (int, error) {
+	offset := -1
+	for i, index := range indices {
+		// Generar el código intermedio para el índice
+		if err := index.Generate(ct); err != nil {
+			return 0, err
+		}
+		result := ct.Pop()
+
+		// Obtener la constante con el tamaño de la dimensión
+		size := &VarNode{Type: "int", Value: IntValue(int64(array.Dims[i])), Pos: pos}
+		if err := size.Generate(ct); err != nil {
+			return 0, err
+		}
+		sizeAddr := ct.Pop()
+
+		// Verificar que el índice esté dentro de los límites del arreglo
+		ct.AddQuad(VER, result, sizeAddr, array.Address, pos)
+
+		if i == 0 {
+			offset = result
+			continue
+		}
+
+		// Desplazamiento en orden por renglones: offset * tamaño + índice
+		scaled, err := ct.newTempVar("int", pos)
+		if err != nil {
+			return 0, err
+		}
+		ct.AddQuad(TIMES, offset, sizeAddr, scaled, pos)
+
+		sum, err := ct.newTempVar("int", pos)
+		if err != nil {
+			return 0, err
+		}
+		ct.AddQuad(PLUS, scaled, result, sum, pos)
+		offset = sum
 	}
 
-	// Agregar los cuádruplo de retorno
-	ct.AddQuad(RETURN, result, -1, -1)
-	ct.AddQuad(ENDFUNC, -1, -1, -1)
+	// Sumar la dirección base al desplazamiento en un apuntador temporal
+	pointer, err := ct.newTempVar("pointer", pos)
+	if err != nil {
+		return 0, err
+	}
+	ct.AddQuad(ADDR, offset, array.Address, pointer, pos)
 
-	return nil
+	return pointer, nil
 }
@@ -0,0 +1,576 @@
+package ast
+
+import (
+	"BabyDuck/token"
+	"errors"
+	"fmt"
+	"math"
+)
+
+// Verificador semántico; resuelve los símbolos y tipos de cada nodo antes
+// de generar el código intermedio
+type Checker struct {
+	Diags   *Diagnostics         // Diagnósticos acumulados
+	FuncDir map[string]*FuncNode // Funciones declaradas
+	Global  map[string]*VarNode  // Variables globales
+	Local   map[string]*VarNode  // Variables de la función actual
+	Func    *FuncNode            // Función actual (nil en el cuerpo principal)
+	Program string               // Nombre del programa
+	Loops   int                  // Ciclos que contienen la sentencia actual
+}
+
+// Crea un verificador que registra sus errores en los diagnósticos indicados
+func NewChecker(diags *Diagnostics) *Checker {
+	return &Checker{
+		Diags:   diags,
+		FuncDir: map[string]*FuncNode{},
+		Global:  map[string]*VarNode{},
+	}
+}
+
+// Registra un error en los diagnósticos para continuar con la verificación.
+// Solo devuelve un error si se alcanzó el límite o si el error es interno
+func (ck *Checker) Report(err error) error {
+	var diag *Diagnostic
+	if !errors.As(err, &diag) {
+		return err
+	}
+	return ck.Diags.Add(diag)
+}
+
+// Busca una variable en el ámbito local y después en el global
+func (ck *Checker) Lookup(id string) (*VarNode, bool) {
+	if varNode, found := ck.Local[id]; found {
+		return varNode, true
+	}
+	varNode, found := ck.Global[id]
+	return varNode, found
+}
+
+// Devuelve el tipo resuelto de una expresión verificada
+func TypeOf(node Attrib) string {
+	switch n := node.(type) {
+	case *VarNode:
+		return n.Type
+	case *ExpressionNode:
+		return n.Type
+	case *ExpressionVar:
+		return n.Type
+	case *FCallNode:
+		return n.Type
+	}
+	return ""
+}
+
+// Declara variables en un ámbito; las duplicadas se reportan y se conserva
+// la primera declaración
+func (ck *Checker) declareVars(scope map[string]*VarNode, vars []*VarNode) error {
+	for _, err := range ValidateVars(vars) {
+		if err := ck.Report(err); err != nil {
+			return err
+		}
+	}
+	for _, err := range ValidateDims(vars) {
+		if err := ck.Report(err); err != nil {
+			return err
+		}
+	}
+	for _, v := range vars {
+		if _, exists := scope[v.Id]; !exists {
+			scope[v.Id] = v
+		}
+	}
+	return nil
+}
+
+// Verifica una lista de sentencias; los errores se registran en los
+// diagnósticos para continuar con la siguiente sentencia
+func (ck *Checker) checkBlock(stmts []Attrib) error {
+	for i, stmt := range stmts {
+		if err := stmt.Check(ck); err != nil {
+			if err := ck.Report(err); err != nil {
+				return err
+			}
+		}
+
+		// Advertir sobre sentencias que nunca se ejecutarán
+		if i == len(stmts)-1 {
+			continue
+		}
+		var jump string
+		var pos token.Pos
+		switch s := stmt.(type) {
+		case *ReturnNode:
+			jump, pos = "return", s.Pos
+		case *BreakNode:
+			jump, pos = "break", s.Pos
+		case *ContinueNode:
+			jump, pos = "continue", s.Pos
+		}
+		if jump != "" {
+			ck.Diags.Add(&Diagnostic{
+				Severity: SeverityWarning,
+				Code:     CodeUnreachable,
+				Pos:      pos,
+				Msg:      fmt.Sprintf("las sentencias después de %s nunca se ejecutan", jump),
+			})
+		}
+	}
+	return nil
+}
+
+// Verifica un operando y devuelve su tipo; si falla, registra el error y
+// devuelve el tipo error para que las expresiones que lo contienen no
+// reporten errores en cascada
+func (ck *Checker) checkOperand(node Attrib) (string, error) {
+	if err := node.Check(ck); err != nil {
+		if err := ck.Report(err); err != nil {
+			return "", err
+		}
+		return ErrorType, nil
+	}
+
+	// Una función void no produce un valor
+	if call, ok := node.(*FCallNode); ok && call.Type == "void" {
+		err := newDiagnostic(CodeInvalidCall, call.Pos, "la función '%s' es de tipo void y no devuelve un valor", call.Id)
+		if err := ck.Report(err); err != nil {
+			return "", err
+		}
+		return ErrorType, nil
+	}
+
+	return TypeOf(node), nil
+}
+
+func (n *ProgramNode) Check(ck *Checker) error {
+	// Los errores se acumulan en los diagnósticos; solo se interrumpe la
+	// verificación por un error interno o al alcanzar el límite de errores
+	if err := n.check(ck); err != nil && err != errTooManyErrors {
+		return err
+	}
+	return ck.Diags.Err()
+}
+
+func (n *ProgramNode) check(ck *Checker) error {
+	ck.Program = n.Id
+
+	// Declarar las variables globales
+	if err := ck.declareVars(ck.Global, n.Vars); err != nil {
+		return err
+	}
+
+	// Registrar las funciones antes de verificarlas para permitir
+	// llamadas entre ellas sin importar el orden de declaración
+	var funcs []*FuncNode
+	for _, funcNode := range n.Funcs {
+		if _, exists := ck.FuncDir[funcNode.Id]; exists || funcNode.Id == n.Id {
+			err := newDiagnostic(CodeDuplicateFunc, funcNode.Pos, "función '%s' ya declarada", funcNode.Id)
+			if err := ck.Report(err); err != nil {
+				return err
+			}
+			continue
+		}
+		ck.FuncDir[funcNode.Id] = funcNode
+		funcs = append(funcs, funcNode)
+	}
+
+	// Verificar las funciones
+	for _, funcNode := range funcs {
+		if err := funcNode.Check(ck); err != nil {
+			return err
+		}
+	}
+
+	// Verificar el cuerpo del programa
+	return ck.checkBlock(n.Body)
+}
+
+func (n *FuncNode) Check(ck *Checker) error {
+	// Establecer el ámbito de la función
+	ck.Func = n
+	ck.Local = map[string]*VarNode{}
+	defer func() {
+		ck.Func = nil
+		ck.Local = nil
+	}()
+
+	// Declarar parámetros y variables locales
+	params := append([]*VarNode{}, n.Params...)
+	if err := ck.declareVars(ck.Local, append(params, n.Vars...)); err != nil {
+		return err
+	}
+
+	// Verificar el cuerpo de la función
+	return ck.checkBlock(n.Body)
+}
+
+func (n *VarNode) Check(ck *Checker) error {
+	// Las constantes ya tienen su tipo desde el análisis sintáctico
+	return nil
+}
+
+func (n *AssignNode) Check(ck *Checker) error {
+	// Verificar la expresión
+	expType, err := ck.checkOperand(n.Exp)
+	if err != nil {
+		return err
+	}
+
+	// Resolver la variable destino y verificar sus índices
+	destNode, found := ck.Lookup(n.Id)
+	if err := ck.checkIndices(destNode, n.Indices, n.Pos); err != nil {
+		return err
+	}
+	if !found {
+		return newDiagnostic(CodeUndeclaredVar, n.Pos, "variable '%s' no declarada", n.Id)
+	}
+	n.Symbol = destNode
+
+	// Verificar que el tipo de la expresión sea compatible con la variable destino
+	if _, err := CheckSemantic(ASSIGN, expType, destNode.Type); err != nil {
+		return errorAt(CodeTypeMismatch, n.Pos, err)
+	}
+
+	return nil
+}
+
+func (n *PrintNode) Check(ck *Checker) error {
+	// Verificar los elementos a imprimir
+	for _, item := range n.Items {
+		if _, err := ck.checkOperand(item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (n *ReadNode) Check(ck *Checker) error {
+	// Resolver las variables destino
+	for _, v := range n.Vars {
+		if err := v.Check(ck); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (n *ExpressionNode) Check(ck *Checker) error {
+	n.Type = ErrorType
+
+	// Verificar los operandos izquierdo y derecho (nil si el operador es unario)
+	leftType, err := ck.checkOperand(n.Left)
+	if err != nil {
+		return err
+	}
+	var rightType string
+	if n.Right != nil {
+		rightType, err = ck.checkOperand(n.Right)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Verificar la división o el módulo entre la constante cero
+	if cte, ok := n.Right.(*VarNode); ok && (n.Op == DIVIDE || n.Op == MOD) && cte.Value == IntValue(0) {
+		return newDiagnostic(CodeDivisionByZero, n.Pos, "división por cero en la expresión")
+	}
+
+	// Verificar la compatibilidad de tipos
+	resultType, err := CheckSemantic(n.Op, leftType, rightType)
+	if err != nil {
+		return errorAt(CodeTypeMismatch, n.Pos, err)
+	}
+	n.Type = resultType
+
+	return nil
+}
+
+func (n *ExpressionVar) Check(ck *Checker) error {
+	n.Type = ErrorType
+
+	// Resolver la variable en el ámbito local o global y verificar sus índices
+	varNode, found := ck.Lookup(n.Id)
+	if err := ck.checkIndices(varNode, n.Indices, n.Pos); err != nil {
+		return err
+	}
+	if !found {
+		return newDiagnostic(CodeUndeclaredVar, n.Pos, "variable '%s' no declarada", n.Id)
+	}
+	n.Symbol = varNode
+	n.Type = varNode.Type
+
+	return nil
+}
+
+// Verifica los índices con los que se accede a una variable; los índices se
+// verifican aunque la variable no exista (nil) para reportar sus errores
+func (ck *Checker) checkIndices(varNode *VarNode, indices []Attrib, pos token.Pos) error {
+	// Verificar las expresiones de los índices
+	indexTypes := make([]string, len(indices))
+	for i, index := range indices {
+		indexType, err := ck.checkOperand(index)
+		if err != nil {
+			return err
+		}
+		indexTypes[i] = indexType
+	}
+	if varNode == nil {
+		return nil
+	}
+
+	// Verificar que el número de índices coincida con las dimensiones
+	switch {
+	case len(varNode.Dims) == 0 && len(indices) > 0:
+		return newDiagnostic(CodeIndex, pos, "la variable '%s' no es un arreglo", varNode.Id)
+	case len(indices) == 0 && len(varNode.Dims) > 0:
+		return newDiagnostic(CodeIndex, pos, "el arreglo '%s' debe usarse con índices", varNode.Id)
+	case len(indices) != len(varNode.Dims):
+		return newDiagnostic(CodeIndex, pos, "el arreglo '%s' tiene %d dimensiones, se recibieron %d índices", varNode.Id, len(varNode.Dims), len(indices))
+	}
+
+	for i, index := range indices {
+		// Verificar que el índice sea entero
+		if indexTypes[i] != "int" && indexTypes[i] != ErrorType {
+			return newDiagnostic(CodeIndex, pos, "el índice del arreglo '%s' debe ser int, se obtuvo %s", varNode.Id, indexTypes[i])
+		}
+
+		// Verificar los límites de los índices constantes
+		if cte, ok := index.(*VarNode); ok && cte.Type == "int" {
+			value := int(cte.Value.Int)
+			if value < 0 || value >= varNode.Dims[i] {
+				return newDiagnostic(CodeIndex, pos, "índice %d fuera de rango para el arreglo '%s' de tamaño %d", value, varNode.Id, varNode.Dims[i])
+			}
+		}
+	}
+
+	return nil
+}
+
+// Verifica que la condición de un estatuto sea de tipo bool
+func (ck *Checker) checkCondition(cond Attrib, pos token.Pos, stmt string) error {
+	condType, err := ck.checkOperand(cond)
+	if err != nil {
+		return err
+	}
+	if condType != "bool" && condType != ErrorType {
+		err := newDiagnostic(CodeCondition, pos, "tipo incompatible en condición %s: se esperaba bool, se obtuvo %s", stmt, condType)
+		if err := ck.Report(err); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (n *IfNode) Check(ck *Checker) error {
+	// Verificar la condición y los bloques
+	if err := ck.checkCondition(n.Condition, n.Pos, "if"); err != nil {
+		return err
+	}
+	if err := ck.checkBlock(n.ThenBlock); err != nil {
+		return err
+	}
+	return ck.checkBlock(n.ElseBlock)
+}
+
+func (n *SwitchNode) Check(ck *Checker) error {
+	// Verificar la expresión
+	expType, err := ck.checkOperand(n.Exp)
+	if err != nil {
+		return err
+	}
+
+	// Verificar cada caso; en un switch numérico los flotantes sin parte
+	// fraccionaria se comparan como enteros para detectar 1 y 1.0 como
+	// repetidos
+	numeric := expType == "int" || expType == "float"
+	seen := map[Value]bool{}
+	for _, c := range n.Cases {
+		if _, err := CheckSemantic(EQ, expType, c.Value.Type); err != nil {
+			err := newDiagnostic(CodeTypeMismatch, c.Pos, "tipo incompatible en case: se esperaba %s, se obtuvo %s", expType, c.Value.Type)
+			if err := ck.Report(err); err != nil {
+				return err
+			}
+		}
+
+		key := c.Value.Value
+		if numeric && key.Kind == KindFloat && key.Float == math.Trunc(key.Float) && math.Abs(key.Float) < math.MaxInt64 {
+			key = IntValue(int64(key.Float))
+		}
+		if seen[key] {
+			err := newDiagnostic(CodeDuplicateCase, c.Pos, "valor %s repetido en los casos del switch", c.Value.Value)
+			if err := ck.Report(err); err != nil {
+				return err
+			}
+		}
+		seen[key] = true
+
+		if err := ck.checkBlock(c.Body); err != nil {
+			return err
+		}
+	}
+	return ck.checkBlock(n.Default)
+}
+
+func (n *WhileNode) Check(ck *Checker) error {
+	// Verificar la condición y el cuerpo del ciclo
+	if err := ck.checkCondition(n.Condition, n.Pos, "while"); err != nil {
+		return err
+	}
+	return ck.checkLoopBody(n.Body)
+}
+
+func (n *ForNode) Check(ck *Checker) error {
+	// Resolver la variable de control; debe ser un escalar int o float
+	varType := ErrorType
+	if err := n.checkControl(ck); err != nil {
+		if err := ck.Report(err); err != nil {
+			return err
+		}
+	} else {
+		varType = n.Symbol.Type
+	}
+
+	// El valor inicial y el incremento deben poder asignarse a la variable
+	// y el límite debe poder compararse con ella
+	bounds := []struct {
+		exp  Attrib
+		op   int
+		desc string
+	}{
+		{n.Start, ASSIGN, "valor inicial"},
+		{n.End, LTE, "límite"},
+		{n.Step, ASSIGN, "incremento"},
+	}
+	for _, b := range bounds {
+		if b.exp == nil {
+			continue
+		}
+		expType, err := ck.checkOperand(b.exp)
+		if err != nil {
+			return err
+		}
+		var semErr error
+		if b.op == ASSIGN {
+			_, semErr = CheckSemantic(ASSIGN, expType, varType)
+		} else {
+			_, semErr = CheckSemantic(b.op, varType, expType)
+		}
+		if semErr != nil {
+			err := newDiagnostic(CodeTypeMismatch, n.Pos, "%s del ciclo for inválido: %v", b.desc, semErr)
+			if err := ck.Report(err); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Un incremento constante de cero nunca termina el ciclo
+	if cte, ok := n.Step.(*VarNode); ok && cte.Value.IsSet() && cte.Value.AsFloat() == 0 {
+		err := newDiagnostic(CodeForLoop, n.Pos, "el incremento del ciclo for no puede ser cero")
+		if err := ck.Report(err); err != nil {
+			return err
+		}
+	}
+
+	return ck.checkLoopBody(n.Body)
+}
+
+// Verifica el cuerpo de un ciclo, donde se permiten break y continue
+func (ck *Checker) checkLoopBody(body []Attrib) error {
+	ck.Loops++
+	defer func() { ck.Loops-- }()
+	return ck.checkBlock(body)
+}
+
+func (n *BreakNode) Check(ck *Checker) error {
+	if ck.Loops == 0 {
+		return newDiagnostic(CodeLoopJump, n.Pos, "break fuera de un ciclo")
+	}
+	return nil
+}
+
+func (n *ContinueNode) Check(ck *Checker) error {
+	if ck.Loops == 0 {
+		return newDiagnostic(CodeLoopJump, n.Pos, "continue fuera de un ciclo")
+	}
+	return nil
+}
+
+// Resuelve la variable de control de un ciclo for y verifica su tipo
+func (n *ForNode) checkControl(ck *Checker) error {
+	varNode, found := ck.Lookup(n.Id)
+	if !found {
+		return newDiagnostic(CodeUndeclaredVar, n.Pos, "variable '%s' no declarada", n.Id)
+	}
+	if err := ck.checkIndices(varNode, nil, n.Pos); err != nil {
+		return err
+	}
+	if varNode.Type != "int" && varNode.Type != "float" {
+		return newDiagnostic(CodeForLoop, n.Pos, "la variable de control '%s' del ciclo for debe ser int o float, se obtuvo %s", n.Id, varNode.Type)
+	}
+	n.Symbol = varNode
+	return nil
+}
+
+func (n *FCallNode) Check(ck *Checker) error {
+	n.Type = ErrorType
+
+	// Verificar los argumentos
+	argTypes := make([]string, len(n.Params))
+	for i, param := range n.Params {
+		argType, err := ck.checkOperand(param)
+		if err != nil {
+			return err
+		}
+		argTypes[i] = argType
+	}
+
+	// Resolver la función en el directorio de funciones
+	if n.Id == ck.Program {
+		return newDiagnostic(CodeInvalidCall, n.Pos, "no se puede llamar a la función '%s'", n.Id)
+	}
+	funcNode, found := ck.FuncDir[n.Id]
+	if !found {
+		return newDiagnostic(CodeUndeclaredFunc, n.Pos, "función '%s' no declarada", n.Id)
+	}
+
+	// Verificar el número de parámetros
+	if len(n.Params) != len(funcNode.Params) {
+		return newDiagnostic(CodeArgCount, n.Pos, "número de parámetros incorrecto para la función '%s': se esperaban %d, se recibieron %d", n.Id, len(funcNode.Params), len(n.Params))
+	}
+
+	// Verificar el tipo de cada parámetro
+	for i, argType := range argTypes {
+		if argType != funcNode.Params[i].Type && argType != ErrorType {
+			return newDiagnostic(CodeArgType, n.Pos, "tipo de parámetro incorrecto en la función '%s': se esperaba %s, se recibió %s", n.Id, funcNode.Params[i].Type, argType)
+		}
+	}
+
+	n.Func = funcNode
+	n.Type = funcNode.ReturnType
+
+	return nil
+}
+
+func (n *ReturnNode) Check(ck *Checker) error {
+	// Verificar el valor de retorno
+	expType, err := ck.checkOperand(n.Exp)
+	if err != nil {
+		return err
+	}
+
+	// Verificar si la función tiene un tipo de retorno
+	if ck.Func == nil || ck.Func.ReturnType == "void" {
+		name := ck.Program
+		if ck.Func != nil {
+			name = ck.Func.Id
+		}
+		return newDiagnostic(CodeInvalidReturn, n.Pos, "la función '%s' es de tipo void", name)
+	}
+
+	// Verificar que el tipo del resultado sea compatible con el tipo de retorno de la función
+	if _, err := CheckSemantic(RETURN, expType, ck.Func.ReturnType); err != nil {
+		return errorAt(CodeTypeMismatch, n.Pos, err)
+	}
+
+	return nil
+}
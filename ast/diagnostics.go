@@ -0,0 +1,147 @@
+package ast
+
+import (
+	"BabyDuck/token"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Máximo de errores reportados por omisión antes de detener el análisis
+const DefaultErrorLimit = 20
+
+// Severidad de un diagnóstico
+type Severity int
+
+const (
+	SeverityError Severity = iota
+	SeverityWarning
+)
+
+func (s Severity) String() string {
+	if s == SeverityWarning {
+		return "warning"
+	}
+	return "error"
+}
+
+// Códigos de diagnóstico
+const (
+	CodeUndeclaredVar  = "E001" // Variable no declarada
+	CodeDuplicateVar   = "E002" // Variable ya declarada en el ámbito
+	CodeUndeclaredFunc = "E003" // Función no declarada
+	CodeDuplicateFunc  = "E004" // Función ya declarada
+	CodeTypeMismatch   = "E005" // Tipos incompatibles
+	CodeArgCount       = "E006" // Número de argumentos incorrecto
+	CodeArgType        = "E007" // Tipo de argumento incorrecto
+	CodeInvalidReturn  = "E008" // Retorno en una función void
+	CodeDivisionByZero = "E009" // División entre la constante cero
+	CodeCondition      = "E010" // Condición que no es bool
+	CodeInvalidCall    = "E011" // Llamada al programa principal
+	CodeMemory         = "E012" // Espacio de memoria insuficiente
+	CodeIndex          = "E013" // Uso inválido de un arreglo
+	CodeForLoop        = "E014" // Variable de control o paso inválido en un ciclo for
+	CodeLoopJump       = "E015" // break o continue fuera de un ciclo
+	CodeDuplicateCase  = "E016" // Valor repetido en los casos de un switch
+	CodeUnreachable    = "W001" // Código inalcanzable después de return, break o continue
+)
+
+// Error devuelto cuando se alcanza el límite de errores
+var errTooManyErrors = errors.New("demasiados errores")
+
+// Diagnóstico estructurado producido durante el análisis semántico
+type Diagnostic struct {
+	Severity Severity
+	Code     string
+	Pos      token.Pos
+	Msg      string
+}
+
+func (d *Diagnostic) Error() string {
+	return formatError(d.Pos, d.Severity.String(), d.Msg) + " [" + d.Code + "]"
+}
+
+// Crea un diagnóstico de error en la posición indicada
+func newDiagnostic(code string, pos token.Pos, format string, a ...any) error {
+	return &Diagnostic{
+		Severity: SeverityError,
+		Code:     code,
+		Pos:      pos,
+		Msg:      fmt.Sprintf(format, a...),
+	}
+}
+
+// Asocia un código y una posición a un error que aún no los tiene
+func errorAt(code string, pos token.Pos, err error) error {
+	var diag *Diagnostic
+	if errors.As(err, &diag) || err == errTooManyErrors {
+		return err
+	}
+	return &Diagnostic{Severity: SeverityError, Code: code, Pos: pos, Msg: err.Error()}
+}
+
+// Colección de diagnósticos de una compilación
+type Diagnostics struct {
+	List      []*Diagnostic
+	Limit     int  // Máximo de errores a reportar (0 = sin límite)
+	Truncated bool // Indica si el análisis se detuvo por el límite
+}
+
+// Agrega un diagnóstico; devuelve errTooManyErrors al alcanzar el límite
+func (d *Diagnostics) Add(diag *Diagnostic) error {
+	d.List = append(d.List, diag)
+	if d.Limit > 0 && d.ErrorCount() >= d.Limit {
+		d.Truncated = true
+		return errTooManyErrors
+	}
+	return nil
+}
+
+// Cuenta los diagnósticos con severidad de error
+func (d *Diagnostics) ErrorCount() int {
+	count := 0
+	for _, diag := range d.List {
+		if diag.Severity == SeverityError {
+			count++
+		}
+	}
+	return count
+}
+
+// Devuelve los diagnósticos ordenados por posición
+func (d *Diagnostics) Sorted() []*Diagnostic {
+	list := append([]*Diagnostic{}, d.List...)
+	sort.SliceStable(list, func(i, j int) bool {
+		if list[i].Pos.Line != list[j].Pos.Line {
+			return list[i].Pos.Line < list[j].Pos.Line
+		}
+		return list[i].Pos.Column < list[j].Pos.Column
+	})
+	return list
+}
+
+// Devuelve los diagnósticos como un solo error si hubo al menos un error
+func (d *Diagnostics) Err() error {
+	if d.ErrorCount() == 0 {
+		return nil
+	}
+	return &DiagnosticError{List: d.Sorted(), Truncated: d.Truncated}
+}
+
+// Error que agrupa todos los diagnósticos de una compilación
+type DiagnosticError struct {
+	List      []*Diagnostic
+	Truncated bool
+}
+
+func (e *DiagnosticError) Error() string {
+	lines := make([]string, len(e.List))
+	for i, diag := range e.List {
+		lines[i] = diag.Error()
+	}
+	if e.Truncated {
+		lines = append(lines, errTooManyErrors.Error()+"; análisis detenido")
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,118 @@
+package ast
+
+import (
+	"BabyDuck/token"
+	"context"
+	"errors"
+	"fmt"
+)
+
+// Errores de ejecución que se pueden identificar con errors.Is
+var (
+	ErrDivisionByZero   = errors.New("división entre cero")
+	ErrIntOverflow      = errors.New("desbordamiento entero")
+	ErrNonFinite        = errors.New("resultado flotante no finito")
+	ErrInstructionLimit = errors.New("límite de instrucciones excedido")
+	ErrMissingInput     = errors.New("falta entrada")
+	ErrInvalidInput     = errors.New("entrada inválida")
+)
+
+// Error detectado durante la ejecución de los cuádruplos
+type RuntimeError struct {
+	IP   int       // Índice del cuádruplo que falló
+	Func string    // Función en ejecución
+	Pos  token.Pos // Posición del código fuente que generó el cuádruplo
+	Err  error     // Causa del error
+}
+
+func (e *RuntimeError) Error() string {
+	text := formatError(e.Pos, "error", fmt.Sprintf("%v (cuádruplo %d, función %s)", e.Err, e.IP, e.Func))
+
+	// Agregar los contextos de la pila si se desbordó
+	var overflow *StackOverflowError
+	if errors.As(e.Err, &overflow) {
+		text += overflow.StackTrace()
+	}
+	return text
+}
+
+func (e *RuntimeError) Unwrap() error {
+	return e.Err
+}
+
+// Error por la cancelación o el vencimiento del contexto de ejecución; envuelve
+// context.Canceled o context.DeadlineExceeded
+type CancelError struct {
+	Err error
+}
+
+func (e *CancelError) Error() string {
+	if errors.Is(e.Err, context.DeadlineExceeded) {
+		return "tiempo de ejecución agotado"
+	}
+	return "ejecución cancelada"
+}
+
+func (e *CancelError) Unwrap() error {
+	return e.Err
+}
+
+// Cantidad de contextos que se incluyen en un StackOverflowError
+const StackTraceFrames = 5
+
+// Contexto de la pila de llamadas incluido en un StackOverflowError
+type TraceFrame struct {
+	Func     string // Función del contexto
+	ReturnIP int    // Cuádruplo al que regresa la llamada (-1 en el cuerpo principal)
+}
+
+// Error por exceder la profundidad máxima de llamadas o la memoria disponible
+type StackOverflowError struct {
+	Reason string       // Límite que se excedió
+	Depth  int          // Profundidad de llamadas al ocurrir el error
+	Frames []TraceFrame // Contextos superiores de la pila, del más reciente al más antiguo
+}
+
+func (e *StackOverflowError) Error() string {
+	return fmt.Sprintf("desbordamiento de pila con %d llamadas activas: %s", e.Depth, e.Reason)
+}
+
+// Da formato a los contextos superiores de la pila, uno por línea
+func (e *StackOverflowError) StackTrace() string {
+	var text string
+	for _, frame := range e.Frames {
+		if frame.ReturnIP < 0 {
+			text += fmt.Sprintf("\n\ten %s", frame.Func)
+		} else {
+			text += fmt.Sprintf("\n\ten %s, regresa al cuádruplo %d", frame.Func, frame.ReturnIP)
+		}
+	}
+	return text
+}
+
+// Asocia el cuádruplo, la función y la posición actuales a un error de ejecución
+func (rt *Runtime) errorAt(ip int, err error) error {
+	var rtErr *RuntimeError
+	if errors.As(err, &rtErr) {
+		return err
+	}
+
+	rtErr = &RuntimeError{IP: ip, Pos: rt.Quads[ip].Pos, Err: err}
+	if frame := rt.CurrentFrame(); frame != nil {
+		rtErr.Func = frame.Func.Node.Id
+	}
+	return rtErr
+}
+
+// Da formato al error en estilo GNU (archivo:línea:columna), igual que errors.Error
+func formatError(pos token.Pos, severity string, msg string) string {
+	text := fmt.Sprintf("%d:%d: %s: ", pos.Line, pos.Column, severity)
+
+	// Agregar el nombre del archivo si el lexer lo conoce
+	switch src := pos.Context.(type) {
+	case token.Sourcer:
+		text = src.Source() + ":" + text
+	}
+
+	return text + msg
+}
@@ -1,6 +1,10 @@
 package ast
 
-import "fmt"
+import (
+	"fmt"
+	"io"
+	"math"
+)
 
 // Direcciones fijas para operadores
 const (
@@ -11,16 +15,27 @@ const (
 	GT      = 4
 	LT      = 5
 	NEQ     = 6
-	ASSIGN  = 7
-	PRINT   = 8
-	PRINTLN = 9
-	GOTO    = 10
-	GOTOF   = 11
-	ERA     = 12
-	PARAM   = 13
-	GOSUB   = 14
-	RETURN  = 15
-	ENDFUNC = 16
+	EQ      = 7
+	LTE     = 8
+	GTE     = 9
+	ASSIGN  = 10
+	PRINT   = 11
+	PRINTLN = 12
+	GOTO    = 13
+	GOTOF   = 14
+	ERA     = 15
+	PARAM   = 16
+	GOSUB   = 17
+	RETURN  = 18
+	ENDFUNC = 19
+	VER     = 20
+	ADDR    = 21
+	AND     = 22
+	OR      = 23
+	NOT     = 24
+	LEN     = 25
+	MOD     = 26
+	READ    = 27
 )
 
 // DEBUG: Lista de operadores para imprimir operación
@@ -32,6 +47,9 @@ var opsList = []string{
 	">",
 	"<",
 	"!=",
+	"==",
+	"<=",
+	">=",
 	"=",
 	"PRINT",
 	"PRINTLN",
@@ -42,6 +60,14 @@ var opsList = []string{
 	"GOSUB",
 	"RETURN",
 	"ENDFUNC",
+	"VER",
+	"ADDR",
+	"&&",
+	"||",
+	"!",
+	"LEN",
+	"%",
+	"READ",
 }
 
 // Memoria de direcciones virtuales
@@ -54,60 +80,43 @@ type Memory struct {
 
 // Segmentos de memoria apartados
 type MemorySegment struct {
-	Int    []*VarNode
-	Float  []*VarNode
-	Bool   []*VarNode
-	String []*VarNode
+	Int     []*VarNode
+	Float   []*VarNode
+	Bool    []*VarNode
+	String  []*VarNode
+	Pointer []*VarNode
 }
 
-func NewMemory() {
-	memory = &Memory{
+func NewMemory() *Memory {
+	return &Memory{
 		Global: &MemorySegment{
-			Int:   []*VarNode{},
-			Float: []*VarNode{},
+			Int:    []*VarNode{},
+			Float:  []*VarNode{},
+			Bool:   []*VarNode{},
+			String: []*VarNode{},
 		},
 		Local: &MemorySegment{
-			Int:   []*VarNode{},
-			Float: []*VarNode{},
+			Int:    []*VarNode{},
+			Float:  []*VarNode{},
+			Bool:   []*VarNode{},
+			String: []*VarNode{},
 		},
 		Const: &MemorySegment{
 			Int:    []*VarNode{},
 			Float:  []*VarNode{},
 			String: []*VarNode{},
+			Bool:   []*VarNode{},
 		},
 		Temp: &MemorySegment{
-			Int:   []*VarNode{},
-			Float: []*VarNode{},
-			Bool:  []*VarNode{},
+			Int:     []*VarNode{},
+			Float:   []*VarNode{},
+			Bool:    []*VarNode{},
+			String:  []*VarNode{},
+			Pointer: []*VarNode{},
 		},
 	}
 }
 
-// Obtiene un nodo de memoria por dirección
-func GetByAddress(address int, frame *StackFrame) (*VarNode, error) {
-	// Obtener el segmento de memoria al que pertenece la dirección
-	m, s := alloc.GetSegment(address, frame)
-
-	// Buscar el nodo en el segmento de memoria
-	if address >= s.Int.Start && address <= s.Int.End {
-		index := address - s.Int.Start
-		return m.Int[index], nil
-	}
-	if address >= s.Float.Start && address <= s.Float.End {
-		index := address - s.Float.Start
-		return m.Float[index], nil
-	}
-	if s.Bool != nil && address >= s.Bool.Start && address <= s.Bool.End {
-		index := address - s.Bool.Start
-		return m.Bool[index], nil
-	}
-	if s.String != nil && address >= s.String.Start && address <= s.String.End {
-		index := address - s.String.Start
-		return m.String[index], nil
-	}
-	return nil, fmt.Errorf("variable con dirección %d no encontrada", address)
-}
-
 // Inserta un nuevo nodo en el segmento de memoria
 func (m *MemorySegment) Insert(node *VarNode) {
 	switch node.Type {
@@ -119,9 +128,50 @@ func (m *MemorySegment) Insert(node *VarNode) {
 		m.Bool = append(m.Bool, node)
 	case "string":
 		m.String = append(m.String, node)
+	case "pointer":
+		m.Pointer = append(m.Pointer, node)
 	}
 }
 
+// Obtiene el número de elementos de una variable (1 si no es arreglo); devuelve
+// -1 si alguna dimensión no es positiva o si el producto desborda un int
+func (n *VarNode) Size() int {
+	size := 1
+	for _, dim := range n.Dims {
+		if dim <= 0 || size > math.MaxInt/dim {
+			return -1
+		}
+		size *= dim
+	}
+	return size
+}
+
+// Crea los nodos de los elementos de un arreglo a partir del segundo; el
+// primer elemento ocupa la dirección base del propio nodo
+func (n *VarNode) ElementNodes() []*VarNode {
+	var nodes []*VarNode
+	for i := 1; i < n.Size(); i++ {
+		// Nombrar el elemento con sus índices, p. ej. m[1][2]
+		id := n.Id
+		rest := i
+		for d := range n.Dims {
+			stride := 1
+			for _, dim := range n.Dims[d+1:] {
+				stride *= dim
+			}
+			id += fmt.Sprintf("[%d]", rest/stride)
+			rest %= stride
+		}
+
+		nodes = append(nodes, &VarNode{
+			Address: n.Address + i,
+			Id:      id,
+			Type:    n.Type,
+		})
+	}
+	return nodes
+}
+
 // Busca una variable por su ID en el segmento de memoria
 func (m *MemorySegment) FindByName(id string) (*VarNode, bool) {
 	for _, node := range m.Int {
@@ -148,7 +198,7 @@ func (m *MemorySegment) FindByName(id string) (*VarNode, bool) {
 }
 
 // Busca una constante por tipo y valor en el segmento de memoria
-func (m *MemorySegment) FindConst(typ string, val string) (*VarNode, bool) {
+func (m *MemorySegment) FindConst(typ string, val Value) (*VarNode, bool) {
 	switch typ {
 	case "int":
 		for _, node := range m.Int {
@@ -185,12 +235,13 @@ func (m *MemorySegment) GetAll() []*VarNode {
 	result = append(result, m.Float...)
 	result = append(result, m.Bool...)
 	result = append(result, m.String...)
+	result = append(result, m.Pointer...)
 	return result
 }
 
 // Obtiene el tamaño del segmento de memoria
 func (m *MemorySegment) Size() int {
-	return len(m.Int) + len(m.Float) + len(m.Bool) + len(m.String)
+	return len(m.Int) + len(m.Float) + len(m.Bool) + len(m.String) + len(m.Pointer)
 }
 
 // Limpia un segmento de memoria
@@ -199,11 +250,17 @@ func (m *MemorySegment) Clear() {
 	m.Float = []*VarNode{}
 	m.Bool = []*VarNode{}
 	m.String = []*VarNode{}
+	m.Pointer = []*VarNode{}
 }
 
 // Imprime el segmento de memoria
-func (m *MemorySegment) Print() {
-	for _, node := range append(m.Int, append(m.Float, append(m.Bool, m.String...)...)...) {
+func (m *MemorySegment) Print(w io.Writer) {
+	printNodes(w, m.GetAll())
+}
+
+// Imprime una lista de nodos de memoria
+func printNodes(w io.Writer, nodes []*VarNode) {
+	for _, node := range nodes {
 		var nodeId string
 		if node.Id != "" {
 			nodeId = fmt.Sprintf("  ID: %s", node.Id)
@@ -214,17 +271,22 @@ func (m *MemorySegment) Print() {
 		var nodeType string
 		if node.Type != "" {
 			nodeType = fmt.Sprintf("  TYPE: %s", node.Type)
+			for _, dim := range node.Dims {
+				nodeType += fmt.Sprintf("[%d]", dim)
+			}
 		} else {
 			nodeType = ""
 		}
 
 		var nodeValue string
-		if node.Value != "" {
+		if node.Value.Kind == KindString {
+			nodeValue = fmt.Sprintf("  VALUE: %q", node.Value.Str)
+		} else if node.Value.IsSet() {
 			nodeValue = fmt.Sprintf("  VALUE: %s", node.Value)
 		} else {
 			nodeValue = ""
 		}
 
-		fmt.Printf("ADDR: %d%s%s%s\n", node.Address, nodeId, nodeType, nodeValue)
+		fmt.Fprintf(w, "ADDR: %d%s%s%s\n", node.Address, nodeId, nodeType, nodeValue)
 	}
 }
@@ -1,12 +1,37 @@
 package ast
 
-import "fmt"
+import (
+	"BabyDuck/token"
+	"fmt"
+	"io"
+	"sort"
+)
 
 // Almacena el contexto de compilación actual
 type Compilation struct {
+	Compiler     *Compiler
+	Diags        *Diagnostics
 	OperandStack []int
 	Quads        []Quadruple
 	TempCount    int
+	loops        []*loopContext // Ciclos en generación, del más externo al más interno
+	calls        []callSite     // Llamadas que se completan al generar todas las funciones
+}
+
+// Llamada a una función cuyo inicio y dirección de retorno pueden no
+// conocerse todavía si se declara después de la llamada
+type callSite struct {
+	Func   *FuncNode
+	Era    int // Índice del cuádruplo ERA
+	Gosub  int // Índice del cuádruplo GOSUB
+	Assign int // Índice de la copia del valor de retorno (-1 en funciones void)
+}
+
+// Saltos pendientes de un ciclo en generación; se completan al conocer el
+// destino de break y continue
+type loopContext struct {
+	breaks    []int // Índices de los GOTO de break
+	continues []int // Índices de los GOTO de continue
 }
 
 // Representa una instrucción de código intermedio (cuádruplo)
@@ -15,6 +40,15 @@ type Quadruple struct {
 	Left     int
 	Right    int
 	Result   int
+	Pos      token.Pos // Posición del código fuente que generó el cuádruplo
+}
+
+// Crea un contexto de compilación asociado a un compilador
+func NewCompilation(c *Compiler) *Compilation {
+	return &Compilation{
+		Compiler: c,
+		Diags:    &Diagnostics{Limit: DefaultErrorLimit},
+	}
 }
 
 // Agrega un operando a la pila de operandos
@@ -39,58 +73,159 @@ func (ct *Compilation) NewTemp() string {
 }
 
 // Agrega un nuevo cuádruplo a la lista
-func (ct *Compilation) AddQuad(operator, left, right, result int) {
+func (ct *Compilation) AddQuad(operator, left, right, result int, pos token.Pos) {
 	ct.Quads = append(ct.Quads, Quadruple{
 		Operator: operator,
 		Left:     left,
 		Right:    right,
 		Result:   result,
+		Pos:      pos,
 	})
 }
 
+// Inicia el contexto de un ciclo para los break y continue de su cuerpo
+func (ct *Compilation) pushLoop() {
+	ct.loops = append(ct.loops, &loopContext{})
+}
+
+// Termina el contexto del ciclo actual y completa sus saltos pendientes
+func (ct *Compilation) popLoop(continueTarget, breakTarget int) {
+	loop := ct.loops[len(ct.loops)-1]
+	ct.loops = ct.loops[:len(ct.loops)-1]
+
+	for _, index := range loop.continues {
+		ct.Quads[index].Result = continueTarget
+	}
+	for _, index := range loop.breaks {
+		ct.Quads[index].Result = breakTarget
+	}
+}
+
+// Completa el inicio y la dirección de retorno de las llamadas generadas;
+// debe llamarse después de generar todas las funciones
+func (ct *Compilation) patchCalls() {
+	for _, call := range ct.calls {
+		ct.Quads[call.Era].Left = call.Func.QuadStart
+		ct.Quads[call.Gosub].Left = call.Func.QuadStart
+		if call.Assign >= 0 {
+			ct.Quads[call.Assign].Left = call.Func.ReturnAddress
+		}
+	}
+	ct.calls = nil
+}
+
 // Resetea el contexto para una nueva función
 func (ct *Compilation) ClearLocalScope() {
+	c := ct.Compiler
+
 	// Restablecer el ámbito global
-	scope = global
+	c.Scope = c.Global
 
 	// Restablecer el contador de cuádruplos
 	ct.TempCount = 0
 
 	// Limpiar la memoria local y temporal
-	memory.Local.Clear()
-	memory.Temp.Clear()
+	c.Memory.Local.Clear()
+	c.Memory.Temp.Clear()
 
 	// Reiniciar los contadores
-	alloc.Local.Reset()
-	alloc.Temp.Reset()
+	c.Alloc.Local.Reset()
+	c.Alloc.Temp.Reset()
 }
 
 // Imprime todos los cuádruplos con sus índices
-func (ct *Compilation) PrintQuads() {
-	fmt.Println()
-	fmt.Println("Cuádruplos generados:")
-	fmt.Println("===================================")
-
-	var left string
-	var right string
-	var result string
+func (ct *Compilation) PrintQuads(w io.Writer) {
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Cuádruplos generados:")
+	fmt.Fprintln(w, "===================================")
+
 	for i, q := range ct.Quads {
-		// DEBUG
-		if q.Left == -1 {
-			left = "_"
-		} else {
-			left = fmt.Sprintf("%d", q.Left)
+		fmt.Fprintf(w, "%d: %s\n", i, q)
+	}
+}
+
+// Da formato a un cuádruplo como (operador, izquierdo, derecho, resultado);
+// los operandos sin usar se muestran como _
+func (q Quadruple) String() string {
+	operand := func(addr int) string {
+		if addr == -1 {
+			return "_"
 		}
-		if q.Right == -1 {
-			right = "_"
-		} else {
-			right = fmt.Sprintf("%d", q.Right)
+		return fmt.Sprintf("%d", addr)
+	}
+	return fmt.Sprintf("(%s, %s, %s, %s)", opsList[q.Operator], operand(q.Left), operand(q.Right), operand(q.Result))
+}
+
+// Imprime el directorio de funciones con el tipo de retorno, el cuádruplo de
+// inicio y las tablas de variables del programa y de cada función
+func (ct *Compilation) PrintSymbols(w io.Writer) {
+	c := ct.Compiler
+
+	fmt.Fprintln(w)
+	fmt.Fprintf(w, "Programa: %s\n", c.Global)
+	fmt.Fprintln(w, "===================================")
+	if mainNode, ok := c.FuncDir[c.Global]; ok {
+		fmt.Fprintf(w, "Inicio: cuádruplo %d\n", mainNode.QuadStart)
+	}
+
+	if c.Memory.Global.Size() > 0 {
+		fmt.Fprintln(w)
+		fmt.Fprintln(w, "Globales:")
+		fmt.Fprintln(w, "===================================")
+		c.Memory.Global.Print(w)
+	}
+
+	if c.Memory.Const.Size() > 0 {
+		fmt.Fprintln(w)
+		fmt.Fprintln(w, "Constantes:")
+		fmt.Fprintln(w, "===================================")
+		c.Memory.Const.Print(w)
+	}
+
+	if c.Memory.Temp.Size() > 0 {
+		fmt.Fprintln(w)
+		fmt.Fprintln(w, "Temporales:")
+		fmt.Fprintln(w, "===================================")
+		c.Memory.Temp.Print(w)
+	}
+
+	// Ordenar las funciones según su posición en los cuádruplos
+	var funcs []*FuncNode
+	for id, funcNode := range c.FuncDir {
+		if id != c.Global {
+			funcs = append(funcs, funcNode)
+		}
+	}
+	sort.Slice(funcs, func(i, j int) bool {
+		return funcs[i].QuadStart < funcs[j].QuadStart
+	})
+
+	for _, funcNode := range funcs {
+		fmt.Fprintln(w)
+		fmt.Fprintf(w, "Función: %s\n", funcNode.Id)
+		fmt.Fprintln(w, "===================================")
+		fmt.Fprintf(w, "Retorno: %s\n", funcNode.ReturnType)
+		fmt.Fprintf(w, "Inicio: cuádruplo %d\n", funcNode.QuadStart)
+
+		if len(funcNode.Params) > 0 {
+			fmt.Fprintln(w)
+			fmt.Fprintln(w, "Parámetros:")
+			fmt.Fprintln(w, "===================================")
+			printNodes(w, funcNode.Params)
 		}
-		if q.Result == -1 {
-			result = "_"
-		} else {
-			result = fmt.Sprintf("%d", q.Result)
+
+		if len(funcNode.Vars) > 0 {
+			fmt.Fprintln(w)
+			fmt.Fprintln(w, "Locales:")
+			fmt.Fprintln(w, "===================================")
+			printNodes(w, funcNode.Vars)
+		}
+
+		if len(funcNode.Temps) > 0 {
+			fmt.Fprintln(w)
+			fmt.Fprintln(w, "Temporales:")
+			fmt.Fprintln(w, "===================================")
+			printNodes(w, funcNode.Temps)
 		}
-		fmt.Printf("%d: (%s, %s, %s, %s)\n", i, opsList[q.Operator], left, right, result)
 	}
 }
@@ -0,0 +1,187 @@
+package ast
+
+import "fmt"
+
+// Tamaño de los bloques de direcciones; todos los rangos del asignador
+// empiezan en un múltiplo de este tamaño y ocupan bloques completos
+const blockSize = 500
+
+// Segmentos de la memoria de ejecución
+const (
+	segNone = iota
+	segGlobal
+	segConst
+	segLocal
+	segTemp
+)
+
+// Decodificación de un bloque de direcciones
+type addrBlock struct {
+	segment int // Segmento al que pertenece el bloque
+	kind    int // Tipo de dato del rango (rangeInt, rangeFloat, ...)
+	start   int // Primera dirección del rango
+}
+
+// Distribución de los tipos de dato dentro de los valores de un segmento
+type segmentLayout struct {
+	offset [rangeCount]int // Posición del primer valor de cada tipo
+	count  [rangeCount]int // Cantidad de valores de cada tipo
+}
+
+// Distribución de los registros de un contexto: locales seguidas de temporales
+type frameLayout struct {
+	Local segmentLayout
+	Temp  segmentLayout
+	Size  int
+}
+
+// Función ejecutable con la distribución de su contexto
+type vmFunc struct {
+	Node   *FuncNode
+	Layout frameLayout
+}
+
+// Crea la distribución de un segmento a partir de la cantidad de direcciones
+// usadas por tipo; los valores se colocan a partir de base
+func newSegmentLayout(used SegmentSize, base int) (segmentLayout, int) {
+	var layout segmentLayout
+	for kind, count := range used {
+		layout.offset[kind] = base
+		layout.count[kind] = count
+		base += count
+	}
+	return layout, base
+}
+
+// Crea la tabla de decodificación de direcciones a partir del asignador
+func newAddrBlocks(a *Allocator) []addrBlock {
+	segments := []struct {
+		id    int
+		alloc AllocSegment
+	}{
+		{segGlobal, a.Global},
+		{segConst, a.Const},
+		{segLocal, a.Local},
+		{segTemp, a.Temp},
+	}
+
+	var blocks []addrBlock
+	for _, seg := range segments {
+		for kind, r := range seg.alloc.Ranges() {
+			if r == nil {
+				continue
+			}
+			// Marcar todos los bloques que cubre el rango
+			for b := r.Start / blockSize; b <= r.End/blockSize; b++ {
+				for len(blocks) <= b {
+					blocks = append(blocks, addrBlock{})
+				}
+				blocks[b] = addrBlock{segment: seg.id, kind: kind, start: r.Start}
+			}
+		}
+	}
+	return blocks
+}
+
+// Obtiene la casilla de memoria de una dirección sin desreferenciar
+// apuntadores; las direcciones locales y temporales se buscan en frame
+func (rt *Runtime) ref(address int, frame *StackFrame) (*Value, error) {
+	// Decodificar el bloque de la dirección
+	b := address / blockSize
+	if address < 0 || b >= len(rt.blocks) || rt.blocks[b].segment == segNone {
+		return nil, fmt.Errorf("variable con dirección %d no encontrada", address)
+	}
+	block := rt.blocks[b]
+	index := address - block.start
+
+	// Obtener los valores y la distribución del segmento
+	var values []Value
+	var layout *segmentLayout
+	switch block.segment {
+	case segGlobal:
+		values, layout = rt.Global, &rt.globalLayout
+	case segConst:
+		values, layout = rt.Const, &rt.constLayout
+	case segLocal:
+		values, layout = rt.Registers[frame.Base:], &frame.Func.Layout.Local
+	case segTemp:
+		values, layout = rt.Registers[frame.Base:], &frame.Func.Layout.Temp
+	}
+
+	if index >= layout.count[block.kind] {
+		return nil, fmt.Errorf("variable con dirección %d no encontrada", address)
+	}
+	return &values[layout.offset[block.kind]+index], nil
+}
+
+// Obtiene la dirección a la que apunta un apuntador temporal; las demás
+// direcciones se devuelven sin cambios
+func (rt *Runtime) resolve(address int, frame *StackFrame) (int, error) {
+	b := address / blockSize
+	if address < 0 || b >= len(rt.blocks) || rt.blocks[b].kind != rangePointer {
+		return address, nil
+	}
+
+	pointer, err := rt.ref(address, frame)
+	if err != nil {
+		return 0, err
+	}
+	if !pointer.IsSet() {
+		return 0, fmt.Errorf("apuntador %s no inicializado", rt.nameOf(address, frame))
+	}
+	return int(pointer.Int), nil
+}
+
+// Obtiene la casilla de memoria de una dirección desreferenciando apuntadores
+func (rt *Runtime) slot(address int, frame *StackFrame) (*Value, error) {
+	target, err := rt.resolve(address, frame)
+	if err != nil {
+		return nil, err
+	}
+	return rt.ref(target, frame)
+}
+
+// Obtiene el valor de una dirección y verifica que esté inicializado
+func (rt *Runtime) read(address int, frame *StackFrame) (Value, error) {
+	target, err := rt.resolve(address, frame)
+	if err != nil {
+		return Value{}, err
+	}
+	value, err := rt.ref(target, frame)
+	if err != nil {
+		return Value{}, err
+	}
+	if !value.IsSet() {
+		return Value{}, fmt.Errorf("variable %s no inicializada", rt.nameOf(target, frame))
+	}
+	return *value, nil
+}
+
+// Obtiene el nombre de la variable con la dirección indicada para los
+// mensajes de error
+func (rt *Runtime) nameOf(address int, frame *StackFrame) string {
+	c := rt.Compiler
+
+	var nodes []*VarNode
+	if b := address / blockSize; address >= 0 && b < len(rt.blocks) {
+		switch rt.blocks[b].segment {
+		case segGlobal:
+			nodes = c.Memory.Global.GetAll()
+		case segConst:
+			nodes = c.Memory.Const.GetAll()
+		case segLocal:
+			for _, v := range append(append([]*VarNode{}, frame.Func.Node.Params...), frame.Func.Node.Vars...) {
+				nodes = append(append(nodes, v), v.ElementNodes()...)
+			}
+		case segTemp:
+			nodes = frame.Func.Node.Temps
+		}
+	}
+
+	for _, node := range nodes {
+		if node.Address == address {
+			return node.Id
+		}
+	}
+	return fmt.Sprintf("%d", address)
+}
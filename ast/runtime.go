@@ -1,51 +1,183 @@
 package ast
 
 import (
+	"bufio"
+	"context"
+	"errors"
 	"fmt"
+	"io"
+	"math"
+	"os"
 	"strconv"
+	"strings"
+	"unicode/utf8"
 )
 
 var debug = false // Imprime la ejecución de cuádruplos
 
+// Límites de ejecución por omisión
+const (
+	DefaultMaxDepth  = 10000   // Llamadas activas
+	DefaultMaxMemory = 1 << 20 // Valores en la pila de registros
+)
+
+// Cada cuántos cuádruplos se revisa la cancelación del contexto
+const cancelCheckInterval = 1024
+
+// Comportamiento de la aritmética entera cuando el resultado no cabe en 64 bits
+type OverflowMode int
+
+const (
+	OverflowWrap OverflowMode = iota // El resultado se trunca a 64 bits (complemento a dos)
+	OverflowTrap                     // El desbordamiento detiene la ejecución con un error
+)
+
 // Contexto de ejecución global
 type Runtime struct {
-	ExecutionStack []*StackFrame
-	ReservedFrame  *StackFrame
-	Quads          []Quadruple
-	Output         []string
+	Compiler        *Compiler
+	ExecutionStack  []StackFrame
+	ReservedFrames  []StackFrame // Contextos reservados por ERA aún sin llamar
+	Quads           []Quadruple
+	Out             io.Writer    // Destino de la salida del programa (os.Stdout por omisión)
+	In              io.Reader    // Origen de la entrada del programa (os.Stdin por omisión)
+	Overflow        OverflowMode // Manejo del desbordamiento entero
+	StrictFloat     bool         // Detiene la ejecución ante resultados flotantes NaN o infinitos
+	MaxDepth        int          // Máximo de llamadas activas (0 = sin límite)
+	MaxMemory       int          // Máximo de valores en la pila de registros (0 = sin límite)
+	MaxInstructions int          // Máximo de cuádruplos a ejecutar (0 = sin límite)
+	Instructions    int          // Cuádruplos ejecutados
+
+	// Memoria de ejecución
+	Global       []Value // Valores globales
+	Const        []Value // Valores constantes
+	Registers    []Value // Pila de registros con las locales y temporales de cada contexto
+	globalLayout segmentLayout
+	constLayout  segmentLayout
+	blocks       []addrBlock     // Decodificación de direcciones por bloque
+	funcs        map[int]*vmFunc // Funciones por cuádruplo de inicio
+	top          int             // Primer registro libre
+	out          *bufio.Writer   // Salida con búfer; se vacía en cada salto de línea
+	lineStarted  bool            // Indica si la línea actual ya tiene algún valor impreso
+	in           *bufio.Reader   // Entrada con búfer
+	afterWord    bool            // Indica si la última lectura fue una palabra y no una línea
 }
 
 // Contexto de ejecución que almacena el estado actual
 type StackFrame struct {
-	Id       string
-	Params   []string
-	Local    *MemorySegment
-	Temp     *MemorySegment
+	Func     *vmFunc
+	Base     int // Primer registro del contexto
 	ReturnIP int
 }
 
 func NewRuntime(ct *Compilation) *Runtime {
-	return &Runtime{
-		ExecutionStack: []*StackFrame{},
-		ReservedFrame:  nil,
+	c := ct.Compiler
+	rt := &Runtime{
+		Compiler:       c,
+		ExecutionStack: []StackFrame{},
+		ReservedFrames: []StackFrame{},
 		Quads:          ct.Quads,
-		Output:         []string{},
+		Out:            os.Stdout,
+		In:             os.Stdin,
+		MaxDepth:       DefaultMaxDepth,
+		MaxMemory:      DefaultMaxMemory,
+		blocks:         newAddrBlocks(c.Alloc),
+		funcs:          map[int]*vmFunc{},
 	}
+
+	// Reservar los valores globales
+	var size int
+	rt.globalLayout, size = newSegmentLayout(c.Alloc.Global.Used(), 0)
+	rt.Global = make([]Value, size)
+
+	// Cargar los valores constantes
+	rt.constLayout, size = newSegmentLayout(c.Alloc.Const.Used(), 0)
+	rt.Const = make([]Value, size)
+	for _, node := range c.Memory.Const.GetAll() {
+		if value, err := rt.ref(node.Address, nil); err == nil {
+			*value = node.Value
+		}
+	}
+
+	// Calcular la distribución del contexto de cada función
+	for _, funcNode := range c.FuncDir {
+		fn := &vmFunc{Node: funcNode}
+		var localSize int
+		fn.Layout.Local, localSize = newSegmentLayout(funcNode.LocalSize, 0)
+		fn.Layout.Temp, fn.Layout.Size = newSegmentLayout(funcNode.TempSize, localSize)
+		rt.funcs[funcNode.QuadStart] = fn
+	}
+
+	// Crear el contexto del cuerpo principal del programa
+	if main, ok := c.FuncDir[c.Global]; ok {
+		rt.ExecutionStack = append(rt.ExecutionStack, rt.reserve(rt.funcs[main.QuadStart]))
+	}
+
+	return rt
 }
 
-// Agrega un nuevo contexto de llamada a la pila de ejecución
+// Reserva los registros de un nuevo contexto en la cima de la pila de
+// registros; la pila solo crece cuando se supera la profundidad máxima
+// alcanzada, por lo que las llamadas no reservan memoria nueva
+func (rt *Runtime) reserve(fn *vmFunc) StackFrame {
+	base := rt.top
+	rt.top += fn.Layout.Size
+	if rt.top > len(rt.Registers) {
+		rt.Registers = append(rt.Registers, make([]Value, rt.top-len(rt.Registers))...)
+	}
+
+	// Limpiar los registros para detectar variables no inicializadas
+	clear(rt.Registers[base:rt.top])
+
+	return StackFrame{Func: fn, Base: base, ReturnIP: -1}
+}
+
+// Verifica que una nueva llamada a fn no exceda la profundidad máxima ni la
+// memoria disponible
+func (rt *Runtime) checkLimits(fn *vmFunc) error {
+	// Las llamadas activas incluyen las reservadas, sin contar el cuerpo principal
+	depth := len(rt.ExecutionStack) - 1 + len(rt.ReservedFrames)
+
+	var reason string
+	switch {
+	case rt.MaxDepth > 0 && depth >= rt.MaxDepth:
+		reason = fmt.Sprintf("límite de %d llamadas", rt.MaxDepth)
+	case rt.MaxMemory > 0 && rt.top+fn.Layout.Size > rt.MaxMemory:
+		reason = fmt.Sprintf("límite de memoria de %d valores", rt.MaxMemory)
+	default:
+		return nil
+	}
+
+	// Incluir los contextos superiores de la pila de ejecución
+	err := &StackOverflowError{Reason: reason, Depth: depth}
+	for i := len(rt.ExecutionStack) - 1; i >= 0 && len(err.Frames) < StackTraceFrames; i-- {
+		frame := rt.ExecutionStack[i]
+		err.Frames = append(err.Frames, TraceFrame{Func: frame.Func.Node.Id, ReturnIP: frame.ReturnIP})
+	}
+	return err
+}
+
+// Agrega el último contexto reservado a la pila de ejecución
 func (rt *Runtime) PushFrame() {
-	rt.ExecutionStack = append(rt.ExecutionStack, rt.ReservedFrame)
-	rt.ReservedFrame = nil
+	frame := *rt.ReservedFrame()
+	rt.ReservedFrames = rt.ReservedFrames[:len(rt.ReservedFrames)-1]
+	rt.ExecutionStack = append(rt.ExecutionStack, frame)
 }
 
-// Saca el contexto de llamada superior de la pila de ejecución
-func (rt *Runtime) PopFrame() *StackFrame {
+// Obtiene el último contexto reservado; las llamadas usadas como argumentos
+// reservan su contexto antes de que se llame a la función exterior
+func (rt *Runtime) ReservedFrame() *StackFrame {
+	return &rt.ReservedFrames[len(rt.ReservedFrames)-1]
+}
+
+// Saca el contexto de llamada superior de la pila de ejecución y libera
+// sus registros
+func (rt *Runtime) PopFrame() StackFrame {
 	if len(rt.ExecutionStack) == 0 {
 		panic("pop en pila vacía")
 	}
 	frame := rt.ExecutionStack[len(rt.ExecutionStack)-1]
 	rt.ExecutionStack = rt.ExecutionStack[:len(rt.ExecutionStack)-1]
+	rt.top = frame.Base
 	return frame
 }
 
@@ -54,18 +186,7 @@ func (rt *Runtime) CurrentFrame() *StackFrame {
 	if len(rt.ExecutionStack) == 0 {
 		return nil
 	}
-	frame := rt.ExecutionStack[len(rt.ExecutionStack)-1]
-	return frame
-}
-
-// Obtiene el nombre de una función por su cuádruplo de inicio
-func (rt *Runtime) GetFunc(quadStart int) *FuncNode {
-	for name, funcNode := range funcDir {
-		if funcNode.QuadStart == quadStart {
-			return funcDir[name]
-		}
-	}
-	return &FuncNode{}
+	return &rt.ExecutionStack[len(rt.ExecutionStack)-1]
 }
 
 // Maneja operaciones de control de flujo
@@ -81,13 +202,13 @@ func (rt *Runtime) handleControlFlow(q Quadruple, ip int) (int, bool, error) {
 
 	case GOTOF:
 		// Obtener el resultado de la condición desde memoria
-		left, err := GetByAddress(q.Left, rt.CurrentFrame())
+		left, err := rt.read(q.Left, rt.CurrentFrame())
 		if err != nil {
 			return ip, true, err
 		}
 
 		// Si la condición es falsa, saltar al cuádruplo indicado
-		if left.Value == "0" {
+		if !left.Bool {
 			ip = q.Result - 1
 			if debug {
 				fmt.Printf("%s %d\n", opsList[q.Operator], q.Result)
@@ -100,11 +221,15 @@ func (rt *Runtime) handleControlFlow(q Quadruple, ip int) (int, bool, error) {
 }
 
 // Maneja operaciones de entrada/salida
-func (rt *Runtime) handleIO(q Quadruple) (bool, error) {
+func (rt *Runtime) handleIO(ctx context.Context, q Quadruple) (bool, error) {
 	switch q.Operator {
 	case PRINTLN:
-		// Imprimir un salto de línea
-		rt.Output = append(rt.Output, "\n")
+		// Imprimir un salto de línea y vaciar el búfer
+		rt.out.WriteByte('\n')
+		rt.lineStarted = false
+		if err := rt.out.Flush(); err != nil {
+			return true, fmt.Errorf("error al escribir la salida: %w", err)
+		}
 		if debug {
 			fmt.Printf("%s\n", opsList[q.Operator])
 		}
@@ -112,124 +237,219 @@ func (rt *Runtime) handleIO(q Quadruple) (bool, error) {
 
 	case PRINT:
 		// Obtener el operando izquierdo desde memoria
-		left, err := GetByAddress(q.Left, rt.CurrentFrame())
+		left, err := rt.read(q.Left, rt.CurrentFrame())
+		if err != nil {
+			return true, err
+		}
+
+		// Imprimir el valor separado por un espacio del valor anterior
+		if rt.lineStarted {
+			rt.out.WriteByte(' ')
+		}
+		rt.out.WriteString(left.String())
+		rt.lineStarted = true
+		if debug {
+			fmt.Printf("%s %s\n", opsList[q.Operator], left)
+		}
+		return true, nil
+
+	case READ:
+		// Vaciar la salida pendiente antes de esperar la entrada
+		if err := rt.out.Flush(); err != nil {
+			return true, fmt.Errorf("error al escribir la salida: %w", err)
+		}
+
+		// Obtener la casilla destino, desreferenciando los elementos de arreglo
+		frame := rt.CurrentFrame()
+		target, err := rt.resolve(q.Result, frame)
+		if err != nil {
+			return true, err
+		}
+		dest, err := rt.ref(target, frame)
 		if err != nil {
 			return true, err
-		} else if left.Value == "" {
-			return true, fmt.Errorf("variable %s no inicializada", left.Id)
-		}
-
-		switch left.Type {
-		case "int", "float":
-			// Imprimir el valor de la variable
-			rt.Output = append(rt.Output, left.Value)
-		case "bool":
-			if left.Value == "1" {
-				rt.Output = append(rt.Output, "true")
-			} else {
-				rt.Output = append(rt.Output, "false")
-			}
-		case "string":
-			// Imprimir el string sin comillas
-			rt.Output = append(rt.Output, left.Value[1:len(left.Value)-1])
 		}
 
+		// Leer un valor del tipo de la variable destino
+		value, err := rt.readInput(ctx, rt.blocks[target/blockSize].kind)
+		var cancelErr *CancelError
+		if errors.As(err, &cancelErr) {
+			return true, err
+		} else if err != nil {
+			return true, fmt.Errorf("%w para la variable '%s' de tipo %s", err, rt.nameOf(target, frame), kindNames[rt.blocks[target/blockSize].kind])
+		}
+		*dest = value
 		if debug {
-			fmt.Printf("%s %s\n", opsList[q.Operator], rt.Output[len(rt.Output)-1])
+			fmt.Printf("%s %d = %s\n", opsList[q.Operator], target, value)
 		}
-		rt.Output = append(rt.Output, " ")
 		return true, nil
 	}
 
 	return false, nil
 }
 
+// Nombres de los tipos de dato por índice de rango
+var kindNames = [rangeCount]string{"int", "float", "bool", "string", "pointer"}
+
+// Lee un valor de la entrada sin impedir la cancelación de ctx; si ctx se
+// cancela mientras espera, la lectura pendiente continúa en segundo plano y
+// consume el siguiente valor de In
+func (rt *Runtime) readInput(ctx context.Context, kind int) (Value, error) {
+	// Un contexto que no puede cancelarse no necesita otra gorutina
+	if ctx.Done() == nil {
+		return rt.readValue(kind)
+	}
+
+	type result struct {
+		value Value
+		err   error
+	}
+	done := make(chan result, 1)
+	go func() {
+		value, err := rt.readValue(kind)
+		done <- result{value, err}
+	}()
+
+	select {
+	case r := <-done:
+		return r.value, r.err
+	case <-ctx.Done():
+		return Value{}, &CancelError{Err: ctx.Err()}
+	}
+}
+
+// Lee un valor del tipo indicado desde la entrada; los números y booleanos
+// se separan por espacios o saltos de línea y los textos ocupan una línea
+func (rt *Runtime) readValue(kind int) (Value, error) {
+	if kind == rangeString {
+		line, err := rt.readLine()
+		if err != nil {
+			return Value{}, err
+		}
+		return StringValue(line), nil
+	}
+
+	word, err := rt.readWord()
+	if err != nil {
+		return Value{}, err
+	}
+
+	// Convertir la palabra al tipo de la variable
+	switch kind {
+	case rangeInt:
+		if i, err := strconv.ParseInt(word, 10, 64); err == nil {
+			return IntValue(i), nil
+		}
+	case rangeFloat:
+		if f, err := strconv.ParseFloat(word, 64); err == nil {
+			return FloatValue(f), nil
+		}
+	case rangeBool:
+		if word == "true" || word == "false" {
+			return BoolValue(word == "true"), nil
+		}
+	}
+	return Value{}, fmt.Errorf("%w %q", ErrInvalidInput, word)
+}
+
+// Lee la siguiente palabra, omitiendo los espacios y saltos de línea previos
+func (rt *Runtime) readWord() (string, error) {
+	var word []byte
+	for {
+		c, err := rt.in.ReadByte()
+		if err == io.EOF && len(word) > 0 {
+			break
+		} else if err == io.EOF {
+			return "", ErrMissingInput
+		} else if err != nil {
+			return "", err
+		}
+
+		// Los espacios terminan la palabra; el salto de línea se conserva
+		if c == ' ' || c == '\t' || c == '\r' || c == '\n' {
+			if len(word) > 0 {
+				rt.in.UnreadByte()
+				break
+			}
+			continue
+		}
+		word = append(word, c)
+	}
+
+	rt.afterWord = true
+	return string(word), nil
+}
+
+// Lee una línea completa; si la lectura anterior fue una palabra, se toma
+// el resto de su línea o, si solo quedan espacios, la línea siguiente
+func (rt *Runtime) readLine() (string, error) {
+	line, err := rt.in.ReadString('\n')
+	if rt.afterWord && strings.TrimSpace(line) == "" && err == nil {
+		line, err = rt.in.ReadString('\n')
+	} else if rt.afterWord {
+		line = strings.TrimLeft(line, " \t")
+	}
+	rt.afterWord = false
+
+	if err == io.EOF && line == "" {
+		return "", ErrMissingInput
+	} else if err != nil && err != io.EOF {
+		return "", err
+	}
+	return strings.TrimRight(line, "\r\n"), nil
+}
+
 // Maneja llamadas a funciones
 func (rt *Runtime) handleFunctionCalls(q Quadruple, ip int) (int, bool, error) {
 	switch q.Operator {
 	case ERA:
-		// Obtener la función desde el directorio
-		funcNode := rt.GetFunc(q.Left)
-
-		// Crear un nuevo contexto de llamada
-		newFrame := &StackFrame{
-			Id:     funcNode.Id,
-			Params: make([]string, len(funcNode.Params)),
-			Local: &MemorySegment{
-				Int:   []*VarNode{},
-				Float: []*VarNode{},
-			},
-			Temp: &MemorySegment{
-				Int:   []*VarNode{},
-				Float: []*VarNode{},
-				Bool:  []*VarNode{},
-			},
-			ReturnIP: -1,
-		}
-
-		// Recrear los parámetros y variables locales de la función
-		for _, v := range append(funcNode.Params, funcNode.Vars...) {
-			newFrame.Local.Insert(&VarNode{
-				Address: v.Address,
-				Id:      v.Id,
-				Type:    v.Type,
-			})
-		}
-
-		// Recrear las variables temporales de la función
-		for _, t := range funcNode.Temps {
-			newFrame.Temp.Insert(&VarNode{
-				Address: t.Address,
-				Id:      t.Id,
-				Type:    t.Type,
-			})
-		}
-
-		// Reservar el espacio de memoria para el nuevo contexto
-		rt.ReservedFrame = newFrame
+		// Obtener la función por su cuádruplo de inicio
+		fn, ok := rt.funcs[q.Left]
+		if !ok {
+			return ip, true, fmt.Errorf("función con inicio en el cuádruplo %d no encontrada", q.Left)
+		}
+
+		// Verificar los límites de profundidad y memoria antes de reservar
+		if err := rt.checkLimits(fn); err != nil {
+			return ip, true, err
+		}
+
+		// Reservar los registros para el nuevo contexto
+		rt.ReservedFrames = append(rt.ReservedFrames, rt.reserve(fn))
 		if debug {
-			fmt.Printf("%s %s\n", opsList[q.Operator], funcNode.Id)
+			fmt.Printf("%s %s\n", opsList[q.Operator], fn.Node.Id)
 		}
 		return ip, true, nil
 
 	case PARAM:
 		// Obtener el operando izquierdo desde la memoria actual
-		left, err := GetByAddress(q.Left, rt.CurrentFrame())
+		left, err := rt.read(q.Left, rt.CurrentFrame())
 		if err != nil {
 			return ip, true, err
-		} else if left.Value == "" {
-			return ip, true, fmt.Errorf("variable %s no inicializada", left.Id)
 		}
 
 		// Obtener el espacio reservado para el nuevo contexto
-		frame := rt.ReservedFrame
+		frame := rt.ReservedFrame()
 
-		// Pasar el parámetro al contexto de llamada
-		frame.Params[q.Result-1] = left.Value
+		// Pasar el parámetro directamente a su registro en el nuevo contexto
+		param := frame.Func.Node.Params[q.Result-1]
+		value, err := rt.ref(param.Address, frame)
+		if err != nil {
+			return ip, true, err
+		}
+		*value = left
 		if debug {
-			funcNode := funcDir[frame.Id]
-			fmt.Printf("%s %s = %s\n", opsList[q.Operator], funcNode.Params[q.Result-1].Id, left.Value)
+			fmt.Printf("%s %s = %s\n", opsList[q.Operator], param.Id, left)
 		}
 		return ip, true, nil
 
 	case GOSUB:
 		// Obtener el espacio reservado para el nuevo contexto
-		frame := rt.ReservedFrame
+		frame := rt.ReservedFrame()
 
 		// Guardar la dirección de retorno
 		frame.ReturnIP = ip + 1
-
-		// Obtener la función desde el directorio
-		funcNode := funcDir[frame.Id]
-
-		// Actualizar los valores locales con los parámetros pasados
-		for i, val := range frame.Params {
-			localNode, err := GetByAddress(funcNode.Params[i].Address, frame)
-			if err != nil {
-				return ip, true, err
-			}
-			localNode.Value = val
-		}
+		funcNode := frame.Func.Node
 
 		// Agregar el nuevo contexto de llamada a la pila
 		rt.PushFrame()
@@ -245,25 +465,22 @@ func (rt *Runtime) handleFunctionCalls(q Quadruple, ip int) (int, bool, error) {
 		// Obtener el contexto de llamada actual
 		frame := rt.CurrentFrame()
 
-		// Obtener la función desde el directorio
-		funcNode := funcDir[frame.Id]
-
 		// Obtener el resultado de la operación desde memoria
-		leftNode, err := GetByAddress(q.Left, frame)
+		left, err := rt.read(q.Left, frame)
 		if err != nil {
 			return ip, true, err
 		}
 
-		// Obtener el nodo de retorno desde la memoria global
-		returnNode, err := GetByAddress(funcNode.ReturnAddress, frame)
+		// Obtener la casilla de retorno desde la memoria global
+		result, err := rt.ref(frame.Func.Node.ReturnAddress, frame)
 		if err != nil {
 			return ip, true, err
 		}
 
 		// Actualiza el valor de retorno
-		returnNode.Value = leftNode.Value
+		*result = left
 		if debug {
-			fmt.Printf("%s %s = %s\n", opsList[q.Operator], returnNode.Id, returnNode.Value)
+			fmt.Printf("%s %s = %s\n", opsList[q.Operator], frame.Func.Node.Id, left)
 		}
 		return ip, true, nil
 
@@ -274,7 +491,7 @@ func (rt *Runtime) handleFunctionCalls(q Quadruple, ip int) (int, bool, error) {
 		// Si hay un contexto de llamada anterior, volver a él
 		ip = frame.ReturnIP - 1
 		if debug {
-			fmt.Printf("%s %s\n", opsList[q.Operator], frame.Id)
+			fmt.Printf("%s %s\n", opsList[q.Operator], frame.Func.Node.Id)
 		}
 		return ip, true, nil
 	}
@@ -289,103 +506,166 @@ func (rt *Runtime) handleAssign(q Quadruple) (bool, error) {
 		frame := rt.CurrentFrame()
 
 		// Obtener el operando izquierdo desde memoria
-		left, err := GetByAddress(q.Left, frame)
+		left, err := rt.read(q.Left, frame)
 		if err != nil {
 			return true, err
-		} else if left.Value == "" {
-			return true, fmt.Errorf("variable %s no inicializada", left.Id)
 		}
 
-		// Obtener el nodo de resultado desde memoria
-		result, err := GetByAddress(q.Result, frame)
+		// Obtener la casilla de resultado desde memoria
+		result, err := rt.slot(q.Result, frame)
 		if err != nil {
 			return true, err
 		}
 
 		// Guardar el resultado en memoria
-		result.Value = left.Value
+		*result = left
 		if debug {
-			fmt.Printf("%s %s %s (%s)\n", result.Id, opsList[q.Operator], result.Value, result.Type)
+			fmt.Printf("%d %s %s\n", q.Result, opsList[q.Operator], left)
 		}
 		return true, nil
 	}
 	return false, nil
 }
 
+// Maneja la verificación de índices y el cálculo de direcciones de arreglos
+func (rt *Runtime) handleArrays(q Quadruple) (bool, error) {
+	switch q.Operator {
+	case VER:
+		// Obtener el contexto de llamada actual
+		frame := rt.CurrentFrame()
+
+		// Obtener el índice desde memoria
+		index, err := rt.read(q.Left, frame)
+		if err != nil {
+			return true, err
+		}
+
+		// Obtener el tamaño de la dimensión desde las constantes
+		size, err := rt.read(q.Right, frame)
+		if err != nil {
+			return true, err
+		}
+
+		// Verificar que el índice esté dentro de los límites
+		if index.Int < 0 || index.Int >= size.Int {
+			return true, fmt.Errorf("índice %d fuera de rango para el arreglo '%s' de tamaño %d", index.Int, rt.nameOf(q.Result, frame), size.Int)
+		}
+		if debug {
+			fmt.Printf("%s %d < %d\n", opsList[q.Operator], index.Int, size.Int)
+		}
+		return true, nil
+
+	case ADDR:
+		// Obtener el contexto de llamada actual
+		frame := rt.CurrentFrame()
+
+		// Obtener el desplazamiento desde memoria
+		offset, err := rt.read(q.Left, frame)
+		if err != nil {
+			return true, err
+		}
+
+		// Obtener el apuntador temporal sin desreferenciarlo
+		pointer, err := rt.ref(q.Result, frame)
+		if err != nil {
+			return true, err
+		}
+
+		// Guardar la dirección del elemento: dirección base + desplazamiento
+		*pointer = IntValue(int64(q.Right) + offset.Int)
+		if debug {
+			fmt.Printf("%s %d = %s\n", opsList[q.Operator], q.Result, pointer)
+		}
+		return true, nil
+	}
+	return false, nil
+}
+
+// Maneja operaciones unarias
+func (rt *Runtime) handleUnary(q Quadruple) (bool, error) {
+	if q.Operator != NOT && q.Operator != LEN {
+		return false, nil
+	}
+
+	// Obtener el contexto de llamada actual
+	frame := rt.CurrentFrame()
+
+	// Obtener el operando desde memoria
+	left, err := rt.read(q.Left, frame)
+	if err != nil {
+		return true, err
+	}
+
+	// Obtener la casilla de resultado desde memoria
+	result, err := rt.slot(q.Result, frame)
+	if err != nil {
+		return true, err
+	}
+
+	*result = unaryOperation(q.Operator, left)
+
+	if debug {
+		fmt.Printf("%s %s = %s\n", opsList[q.Operator], left, result)
+	}
+	return true, nil
+}
+
 // Maneja operaciones aritméticas y relacionales
 func (rt *Runtime) handleArithmetic(q Quadruple) error {
 	// Obtener el contexto de llamada actual
 	frame := rt.CurrentFrame()
 
 	// Obtener el operando izquierdo desde memoria
-	left, err := GetByAddress(q.Left, frame)
+	left, err := rt.read(q.Left, frame)
 	if err != nil {
 		return err
-	} else if left.Value == "" {
-		return fmt.Errorf("variable %s no inicializada", left.Id)
 	}
 
 	// Obtener el operando derecho desde memoria
-	right, err := GetByAddress(q.Right, frame)
+	right, err := rt.read(q.Right, frame)
 	if err != nil {
 		return err
-	} else if right.Value == "" {
-		return fmt.Errorf("variable %s no inicializada", right.Id)
 	}
 
-	// Obtener el nodo de resultado desde memoria
-	result, err := GetByAddress(q.Result, frame)
+	// Obtener la casilla de resultado desde memoria
+	result, err := rt.slot(q.Result, frame)
 	if err != nil {
 		return err
 	}
 
-	// Ejecutar la operación
-	var floatResult float64
-	lVal := left.Value
-	lTyp := left.Type
-	rVal := right.Value
-	rTyp := right.Type
-
-	// Operadores aritméticos y relacionales
-	switch q.Operator {
-	case PLUS:
-		floatResult = valToFloat(lVal, lTyp) + valToFloat(rVal, rTyp)
-	case MINUS:
-		floatResult = valToFloat(lVal, lTyp) - valToFloat(rVal, rTyp)
-	case TIMES:
-		floatResult = valToFloat(lVal, lTyp) * valToFloat(rVal, rTyp)
-	case DIVIDE:
-		floatResult = valToFloat(lVal, lTyp) / valToFloat(rVal, rTyp)
-	case GT:
-		boolResult := valToFloat(lVal, lTyp) > valToFloat(rVal, rTyp)
-		floatResult = valToFloat(fmt.Sprintf("%t", boolResult), "bool")
-	case LT:
-		boolResult := valToFloat(lVal, lTyp) < valToFloat(rVal, rTyp)
-		floatResult = valToFloat(fmt.Sprintf("%t", boolResult), "bool")
-	case NEQ:
-		boolResult := valToFloat(lVal, lTyp) != valToFloat(rVal, rTyp)
-		floatResult = valToFloat(fmt.Sprintf("%t", boolResult), "bool")
-	}
-
-	// Normalizar a string según el tipo de resultado
-	var stringValue string
-	switch result.Type {
-	case "int", "bool":
-		stringValue = fmt.Sprintf("%d", int(floatResult))
-	case "float":
-		stringValue = fmt.Sprintf("%f", floatResult)
+	// Ejecutar la operación según el tipo de los operandos
+	value, err := rt.operate(q.Operator, left, right)
+	if err != nil {
+		return err
 	}
 
 	// Guardar el resultado en memoria
-	result.Value = stringValue
+	*result = value
 	if debug {
-		fmt.Printf("%s %s %s = %s (%s)\n", left.Value, opsList[q.Operator], right.Value, result.Value, result.Type)
+		fmt.Printf("%s %s %s = %s\n", left, opsList[q.Operator], right, value)
 	}
 	return nil
 }
 
-// Ejecuta los cuádruplos generados
-func (rt *Runtime) RunProgram() error {
+// Ejecuta los cuádruplos generados hasta terminar, hasta que ctx se cancele
+// o hasta agotar MaxInstructions; la salida pendiente se escribe en Out
+// aun si la ejecución falla. Un READ que espera la entrada también se
+// interrumpe al cancelar ctx, pero la lectura de In queda pendiente hasta
+// que llegue un valor, por lo que In no debe reutilizarse en otra ejecución
+func (rt *Runtime) RunProgram(ctx context.Context) error {
+	rt.out = bufio.NewWriter(rt.Out)
+	rt.in = bufio.NewReader(rt.In)
+	err := rt.execute(ctx)
+
+	// Vaciar la salida de la última línea si no terminó con salto de línea
+	if flushErr := rt.out.Flush(); err == nil && flushErr != nil {
+		return fmt.Errorf("error al escribir la salida: %w", flushErr)
+	}
+	return err
+}
+
+// Ciclo principal de ejecución de los cuádruplos
+func (rt *Runtime) execute(ctx context.Context) error {
 	if debug {
 		fmt.Println()
 		fmt.Println("Ejecución de cuádruplos")
@@ -396,25 +676,38 @@ func (rt *Runtime) RunProgram() error {
 	for ip := 0; ip < len(rt.Quads); ip++ {
 		q := rt.Quads[ip]
 
+		// Verificar el límite de instrucciones
+		rt.Instructions++
+		if rt.MaxInstructions > 0 && rt.Instructions > rt.MaxInstructions {
+			return rt.errorAt(ip, fmt.Errorf("%w: se ejecutaron %d cuádruplos", ErrInstructionLimit, rt.MaxInstructions))
+		}
+
+		// Verificar la cancelación periódicamente para no frenar la ejecución
+		if rt.Instructions%cancelCheckInterval == 1 {
+			if err := ctx.Err(); err != nil {
+				return rt.errorAt(ip, &CancelError{Err: err})
+			}
+		}
+
 		// Manejar operaciones de control de flujo
 		if newIP, handled, err := rt.handleControlFlow(q, ip); handled {
 			if err != nil {
-				return err
+				return rt.errorAt(ip, err)
 			}
 			ip = newIP
 			continue
 		}
 		// Manejar operaciones de entrada/salida
-		if handled, err := rt.handleIO(q); handled {
+		if handled, err := rt.handleIO(ctx, q); handled {
 			if err != nil {
-				return err
+				return rt.errorAt(ip, err)
 			}
 			continue
 		}
 		// Manejar llamadas a funciones
 		if newIP, handled, err := rt.handleFunctionCalls(q, ip); handled {
 			if err != nil {
-				return err
+				return rt.errorAt(ip, err)
 			}
 			ip = newIP
 			continue
@@ -422,54 +715,197 @@ func (rt *Runtime) RunProgram() error {
 		// Manejar asignaciones
 		if handled, err := rt.handleAssign(q); handled {
 			if err != nil {
-				return err
+				return rt.errorAt(ip, err)
+			}
+			continue
+		}
+		// Manejar operaciones de arreglos
+		if handled, err := rt.handleArrays(q); handled {
+			if err != nil {
+				return rt.errorAt(ip, err)
+			}
+			continue
+		}
+		// Manejar operaciones unarias
+		if handled, err := rt.handleUnary(q); handled {
+			if err != nil {
+				return rt.errorAt(ip, err)
 			}
 			continue
 		}
 		// Manejar operaciones aritméticas y relacionales
 		if err := rt.handleArithmetic(q); err != nil {
-			return err
+			return rt.errorAt(ip, err)
 		}
 	}
 
 	return nil
 }
 
-// Limpia el contexto de ejecución y la memoria
-func (rt *Runtime) Clear() {
-	NewMemory()
-	alloc = &Allocator{}
-	rt.ExecutionStack = nil
-	rt.ReservedFrame = nil
-	for k := range funcDir {
-		delete(funcDir, k)
+// Redirige la salida del programa a un búfer en memoria y lo devuelve; útil
+// para comparar la salida en las pruebas
+func (rt *Runtime) Capture() *strings.Builder {
+	var output strings.Builder
+	rt.Out = &output
+	return &output
+}
+
+// Ejecuta una operación binaria según el tipo de los operandos
+func (rt *Runtime) operate(op int, left Value, right Value) (Value, error) {
+	switch {
+	case left.Kind == KindString:
+		return stringOperation(op, left.Str, right.Str)
+	case left.Kind == KindBool:
+		return boolOperation(op, left.Bool, right.Bool)
+	case left.Kind == KindInt && right.Kind == KindInt:
+		return rt.intOperation(op, left.Int, right.Int)
+	}
+	// Las operaciones mixtas se realizan en punto flotante
+	return rt.floatOperation(op, left.AsFloat(), right.AsFloat())
+}
+
+// Ejecuta una operación unaria (NOT o LEN)
+func unaryOperation(op int, value Value) Value {
+	if op == NOT {
+		// Negar el valor booleano
+		return BoolValue(!value.Bool)
+	}
+	// Contar los caracteres del texto
+	return IntValue(int64(utf8.RuneCountInString(value.Str)))
+}
+
+// Evalúa al compilar una operación entre constantes con las mismas reglas
+// que la máquina virtual; right se ignora en las operaciones unarias.
+// Devuelve false si el resultado depende de las opciones de ejecución, como
+// un desbordamiento, una división entre cero o un resultado no finito
+func Fold(op int, left Value, right Value) (Value, bool) {
+	switch op {
+	case NOT, LEN:
+		return unaryOperation(op, left), true
+	case PLUS, MINUS, TIMES, DIVIDE, MOD, GT, LT, NEQ, EQ, LTE, GTE:
+		strict := Runtime{Overflow: OverflowTrap, StrictFloat: true}
+		value, err := strict.operate(op, left, right)
+		return value, err == nil
+	}
+	return Value{}, false
+}
+
+// Ejecuta una operación aritmética o relacional entre enteros; la división
+// trunca hacia cero y el módulo conserva el signo del dividendo
+func (rt *Runtime) intOperation(op int, left int64, right int64) (Value, error) {
+	trap := rt.Overflow == OverflowTrap
+
+	switch op {
+	case PLUS:
+		sum := left + right
+		if trap && (left >= 0) == (right >= 0) && (sum >= 0) != (left >= 0) {
+			return Value{}, overflowError(op, left, right)
+		}
+		return IntValue(sum), nil
+	case MINUS:
+		diff := left - right
+		if trap && (left >= 0) != (right >= 0) && (diff >= 0) != (left >= 0) {
+			return Value{}, overflowError(op, left, right)
+		}
+		return IntValue(diff), nil
+	case TIMES:
+		product := left * right
+		if trap && left != 0 && (product/left != right || (left == -1 && right == math.MinInt64)) {
+			return Value{}, overflowError(op, left, right)
+		}
+		return IntValue(product), nil
+	case DIVIDE:
+		if right == 0 {
+			return Value{}, ErrDivisionByZero
+		}
+		if trap && left == math.MinInt64 && right == -1 {
+			return Value{}, overflowError(op, left, right)
+		}
+		return IntValue(left / right), nil
+	case MOD:
+		if right == 0 {
+			return Value{}, fmt.Errorf("%w en el módulo", ErrDivisionByZero)
+		}
+		return IntValue(left % right), nil
+	case GT:
+		return BoolValue(left > right), nil
+	case LT:
+		return BoolValue(left < right), nil
+	case NEQ:
+		return BoolValue(left != right), nil
+	case EQ:
+		return BoolValue(left == right), nil
+	case LTE:
+		return BoolValue(left <= right), nil
+	case GTE:
+		return BoolValue(left >= right), nil
+	}
+	return Value{}, fmt.Errorf("operación %s inválida entre enteros", opsList[op])
+}
+
+// Crea el error de un desbordamiento entero
+func overflowError(op int, left int64, right int64) error {
+	return fmt.Errorf("%w en %d %s %d", ErrIntOverflow, left, opsList[op], right)
+}
+
+// Ejecuta una operación aritmética o relacional entre flotantes; en modo
+// estricto los resultados NaN o infinitos producen un error
+func (rt *Runtime) floatOperation(op int, left float64, right float64) (Value, error) {
+	var result float64
+	switch op {
+	case PLUS:
+		result = left + right
+	case MINUS:
+		result = left - right
+	case TIMES:
+		result = left * right
+	case DIVIDE:
+		if rt.StrictFloat && right == 0 {
+			return Value{}, ErrDivisionByZero
+		}
+		result = left / right
+	case GT:
+		return BoolValue(left > right), nil
+	case LT:
+		return BoolValue(left < right), nil
+	case NEQ:
+		return BoolValue(left != right), nil
+	case EQ:
+		return BoolValue(left == right), nil
+	case LTE:
+		return BoolValue(left <= right), nil
+	case GTE:
+		return BoolValue(left >= right), nil
+	default:
+		return Value{}, fmt.Errorf("operación %s inválida entre flotantes", opsList[op])
+	}
+
+	if rt.StrictFloat && (math.IsNaN(result) || math.IsInf(result, 0)) {
+		return Value{}, fmt.Errorf("%w en %s %s %s", ErrNonFinite, FloatValue(left), opsList[op], FloatValue(right))
 	}
+	return FloatValue(result), nil
 }
 
-func (Runtime *Runtime) PrintOutput() {
-	fmt.Println()
-	fmt.Println("Salida del programa:")
-	fmt.Println("===================================")
-	for _, out := range Runtime.Output {
-		fmt.Print(out)
+// Ejecuta una comparación entre booleanos
+func boolOperation(op int, left bool, right bool) (Value, error) {
+	switch op {
+	case EQ:
+		return BoolValue(left == right), nil
+	case NEQ:
+		return BoolValue(left != right), nil
 	}
-	fmt.Println()
+	return Value{}, fmt.Errorf("operación %s inválida entre booleanos", opsList[op])
 }
 
-// Convierte el valor a tipo float64
-func valToFloat(val string, typ string) float64 {
-	switch typ {
-	case "int":
-		intVal, _ := strconv.Atoi(val)
-		return float64(intVal)
-	case "float":
-		floatVal, _ := strconv.ParseFloat(val, 64)
-		return floatVal
-	case "bool":
-		if val == "true" || val == "1" {
-			return 1
-		}
-		return 0
-	}
-	return 0
+// Ejecuta una concatenación o comparación entre textos
+func stringOperation(op int, left string, right string) (Value, error) {
+	switch op {
+	case PLUS:
+		return StringValue(left + right), nil
+	case EQ:
+		return BoolValue(left == right), nil
+	case NEQ:
+		return BoolValue(left != right), nil
+	}
+	return Value{}, fmt.Errorf("operación %s inválida entre textos", opsList[op])
 }
@@ -12,6 +12,9 @@ var semanticCube = map[int]map[string]map[string]string{
 			"int":   "float",
 			"float": "float",
 		},
+		"string": {
+			"string": "string",
+		},
 	},
 	MINUS: {
 		"int": {
@@ -43,6 +46,11 @@ var semanticCube = map[int]map[string]map[string]string{
 			"float": "float",
 		},
 	},
+	MOD: {
+		"int": {
+			"int": "int",
+		},
+	},
 	GT: {
 		"int": {
 			"int":   "bool",
@@ -75,6 +83,66 @@ var semanticCube = map[int]map[string]map[string]string{
 		"bool": {
 			"bool": "bool",
 		},
+		"string": {
+			"string": "bool",
+		},
+	},
+	EQ: {
+		"int": {
+			"int":   "bool",
+			"float": "bool",
+		},
+		"float": {
+			"int":   "bool",
+			"float": "bool",
+		},
+		"bool": {
+			"bool": "bool",
+		},
+		"string": {
+			"string": "bool",
+		},
+	},
+	LTE: {
+		"int": {
+			"int":   "bool",
+			"float": "bool",
+		},
+		"float": {
+			"int":   "bool",
+			"float": "bool",
+		},
+	},
+	GTE: {
+		"int": {
+			"int":   "bool",
+			"float": "bool",
+		},
+		"float": {
+			"int":   "bool",
+			"float": "bool",
+		},
+	},
+	AND: {
+		"bool": {
+			"bool": "bool",
+		},
+	},
+	OR: {
+		"bool": {
+			"bool": "bool",
+		},
+	},
+	// Operador unario: el tipo derecho es vacío
+	NOT: {
+		"bool": {
+			"": "bool",
+		},
+	},
+	LEN: {
+		"string": {
+			"": "int",
+		},
 	},
 	ASSIGN: {
 		"int": {
@@ -83,6 +151,12 @@ var semanticCube = map[int]map[string]map[string]string{
 		"float": {
 			"float": "float",
 		},
+		"bool": {
+			"bool": "bool",
+		},
+		"string": {
+			"string": "string",
+		},
 	},
 	RETURN: {
 		"int": {
@@ -91,10 +165,27 @@ var semanticCube = map[int]map[string]map[string]string{
 		"float": {
 			"float": "float",
 		},
+		"bool": {
+			"bool": "bool",
+		},
+		"string": {
+			"string": "string",
+		},
 	},
 }
 
 func CheckSemantic(op int, left string, right string) (string, error) {
+	// Un operando inválido ya fue reportado; el resultado también es inválido
+	if left == ErrorType || right == ErrorType {
+		return ErrorType, nil
+	}
+	// Los operadores unarios no tienen operando derecho
+	if right == "" {
+		if result, ok := semanticCube[op][left][right]; ok {
+			return result, nil
+		}
+		return "", fmt.Errorf("operación %s inválida para %s", opsList[op], left)
+	}
 	if _, ok := semanticCube[op][left]; !ok {
 		return "", fmt.Errorf("tipo izquierdo no soportado: %s", left)
 	}
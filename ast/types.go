@@ -1,11 +1,23 @@
 package ast
 
-var memory *Memory                   // Memoria virtual para variables y constantes
-var alloc *Allocator                 // Asignador de memoria para variables
-var funcDir = map[string]*FuncNode{} // Tabla de funciones registradas
+import "BabyDuck/token"
+
+// Contexto independiente de compilación; permite compilar y ejecutar
+// varios programas en paralelo sin compartir estado
+type Compiler struct {
+	FuncDir map[string]*FuncNode // Tabla de funciones registradas
+	Memory  *Memory              // Memoria virtual para variables y constantes
+	Alloc   *Allocator           // Asignador de memoria para variables
+	Scope   string               // Ámbito actual
+	Global  string               // Ámbito global
+}
+
+// Tipo de las expresiones inválidas durante la verificación
+const ErrorType = "error"
 
 // Attrib es la interfaz general para todo tipo en el árbol AST
 type Attrib interface {
+	Check(ck *Checker) error
 	Generate(ct *Compilation) error
 }
 
@@ -15,6 +27,7 @@ type ProgramNode struct {
 	Vars  []*VarNode
 	Funcs []*FuncNode
 	Body  []Attrib
+	Pos   token.Pos
 }
 
 // Nodo de función
@@ -23,10 +36,13 @@ type FuncNode struct {
 	Params        []*VarNode
 	Vars          []*VarNode
 	Temps         []*VarNode
+	LocalSize     SegmentSize // Direcciones locales usadas por tipo
+	TempSize      SegmentSize // Direcciones temporales usadas por tipo
 	Body          []Attrib
 	QuadStart     int
 	ReturnType    string
 	ReturnAddress int
+	Pos           token.Pos
 }
 
 // Nodo de variable
@@ -34,18 +50,30 @@ type VarNode struct {
 	Address int
 	Id      string
 	Type    string
-	Value   string
+	Value   Value
+	Dims    []int // Tamaño de cada dimensión (nil si no es arreglo)
+	Pos     token.Pos
 }
 
 // Nodo de asignación
 type AssignNode struct {
-	Id  string
-	Exp Attrib
+	Id      string
+	Indices []Attrib // Índices del elemento destino si es un arreglo
+	Exp     Attrib
+	Symbol  *VarNode // Variable destino resuelta por el verificador
+	Pos     token.Pos
 }
 
 // Nodo de impresión
 type PrintNode struct {
 	Items []Attrib
+	Pos   token.Pos
+}
+
+// Nodo de lectura
+type ReadNode struct {
+	Vars []*ExpressionVar // Variables o elementos de arreglo destino
+	Pos  token.Pos
 }
 
 // Nodo de expresión binaria
@@ -53,11 +81,17 @@ type ExpressionNode struct {
 	Op    int
 	Left  Attrib
 	Right Attrib
+	Type  string // Tipo resuelto por el verificador
+	Pos   token.Pos
 }
 
 // Nodo auxiliar para variables en expresiones
 type ExpressionVar struct {
-	Id string
+	Id      string
+	Indices []Attrib // Índices del elemento si es un arreglo
+	Symbol  *VarNode // Declaración resuelta por el verificador
+	Type    string   // Tipo resuelto por el verificador
+	Pos     token.Pos
 }
 
 // Nodo de condición
@@ -65,21 +99,63 @@ type IfNode struct {
 	Condition Attrib
 	ThenBlock []Attrib
 	ElseBlock []Attrib
+	Pos       token.Pos
+}
+
+// Nodo de selección múltiple
+type SwitchNode struct {
+	Exp     Attrib
+	Cases   []*CaseNode
+	Default []Attrib // Sentencias del caso default (vacío si se omite)
+	Pos     token.Pos
+}
+
+// Caso de un switch; no continúa con el siguiente caso al terminar
+type CaseNode struct {
+	Value *VarNode // Constante con la que se compara la expresión
+	Body  []Attrib
+	Pos   token.Pos
 }
 
 // Nodo de ciclo while
 type WhileNode struct {
 	Condition Attrib
 	Body      []Attrib
+	Pos       token.Pos
+}
+
+// Nodo de ciclo for con contador
+type ForNode struct {
+	Id     string // Variable de control
+	Start  Attrib // Valor inicial
+	End    Attrib // Límite, incluido en el recorrido
+	Step   Attrib // Incremento (nil si se omite, equivale a 1)
+	Body   []Attrib
+	Symbol *VarNode // Variable de control resuelta por el verificador
+	Pos    token.Pos
+}
+
+// Nodo de salida de un ciclo
+type BreakNode struct {
+	Pos token.Pos
+}
+
+// Nodo de paso a la siguiente iteración de un ciclo
+type ContinueNode struct {
+	Pos token.Pos
 }
 
 // Nodo de llamada a función
 type FCallNode struct {
 	Id     string
 	Params []Attrib
+	Func   *FuncNode // Función resuelta por el verificador
+	Type   string    // Tipo de retorno resuelto por el verificador
+	Pos    token.Pos
 }
 
 // Nodo de retorno de función
 type ReturnNode struct {
 	Exp Attrib
+	Pos token.Pos
 }
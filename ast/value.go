@@ -0,0 +1,87 @@
+package ast
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Tipo del dato almacenado en un valor
+type Kind uint8
+
+const (
+	KindNone Kind = iota // Valor no inicializado
+	KindInt
+	KindFloat
+	KindBool
+	KindString
+)
+
+// Valor en tiempo de ejecución; solo el campo que corresponde a Kind es válido
+type Value struct {
+	Kind  Kind
+	Int   int64
+	Float float64
+	Bool  bool
+	Str   string
+}
+
+// Crea un valor entero
+func IntValue(i int64) Value {
+	return Value{Kind: KindInt, Int: i}
+}
+
+// Crea un valor flotante
+func FloatValue(f float64) Value {
+	return Value{Kind: KindFloat, Float: f}
+}
+
+// Crea un valor booleano
+func BoolValue(b bool) Value {
+	return Value{Kind: KindBool, Bool: b}
+}
+
+// Crea un valor de texto
+func StringValue(s string) Value {
+	return Value{Kind: KindString, Str: s}
+}
+
+// Indica si el valor ya fue inicializado
+func (v Value) IsSet() bool {
+	return v.Kind != KindNone
+}
+
+// Convierte un valor numérico a float64 para operaciones mixtas
+func (v Value) AsFloat() float64 {
+	if v.Kind == KindInt {
+		return float64(v.Int)
+	}
+	return v.Float
+}
+
+// Devuelve el valor numérico con el signo contrario
+func (v Value) Negate() Value {
+	if v.Kind == KindFloat {
+		return FloatValue(-v.Float)
+	}
+	return IntValue(-v.Int)
+}
+
+// Da formato al valor tal como lo imprime el programa
+func (v Value) String() string {
+	switch v.Kind {
+	case KindInt:
+		return strconv.FormatInt(v.Int, 10)
+	case KindFloat:
+		// Usar la representación más corta que conserva el valor exacto
+		s := strconv.FormatFloat(v.Float, 'f', -1, 64)
+		if !strings.ContainsAny(s, ".IN") {
+			s += ".0"
+		}
+		return s
+	case KindBool:
+		return strconv.FormatBool(v.Bool)
+	case KindString:
+		return v.Str
+	}
+	return ""
+}
@@ -0,0 +1,261 @@
+package cfg
+
+import (
+	"BabyDuck/ast"
+	"sort"
+)
+
+// Bloque básico: secuencia de cuádruplos que solo recibe saltos en el
+// primero y solo salta en el último
+type Block struct {
+	Index     int      // Posición del bloque en el grafo
+	Start     int      // Primer cuádruplo del bloque
+	End       int      // Cuádruplo siguiente al último del bloque
+	Succs     []*Block // Bloques que pueden ejecutarse a continuación
+	Preds     []*Block // Bloques que pueden ejecutarse antes
+	Idom      *Block   // Dominador inmediato (nil en la entrada y en los bloques inalcanzables)
+	Reachable bool     // Indica si el bloque se alcanza desde la entrada
+}
+
+// Ciclo natural: un encabezado que domina a los bloques que regresan a él
+type Loop struct {
+	Header *Block
+	Blocks []*Block // Bloques del ciclo, incluido el encabezado, en orden
+}
+
+// Grafo de flujo de control de una función
+type Graph struct {
+	Func   *ast.FuncNode
+	Start  int      // Primer cuádruplo de la función
+	End    int      // Cuádruplo siguiente al último de la función
+	Blocks []*Block // Bloques en el orden de sus cuádruplos; el primero es la entrada
+	Loops  []*Loop  // Ciclos ordenados por su encabezado
+}
+
+// Construye el grafo de cada función y del cuerpo principal, ordenados por
+// su cuádruplo de inicio; el GOTO inicial del programa no pertenece a
+// ninguno
+func Build(ct *ast.Compilation) []*Graph {
+	funcs := make([]*ast.FuncNode, 0, len(ct.Compiler.FuncDir))
+	for _, funcNode := range ct.Compiler.FuncDir {
+		funcs = append(funcs, funcNode)
+	}
+	sort.Slice(funcs, func(i, j int) bool {
+		return funcs[i].QuadStart < funcs[j].QuadStart
+	})
+
+	graphs := make([]*Graph, len(funcs))
+	for i, funcNode := range funcs {
+		end := len(ct.Quads)
+		if i+1 < len(funcs) {
+			end = funcs[i+1].QuadStart
+		}
+		graphs[i] = New(ct.Quads, funcNode, funcNode.QuadStart, end)
+	}
+	return graphs
+}
+
+// Construye el grafo de los cuádruplos [start, end) de una función
+func New(quads []ast.Quadruple, funcNode *ast.FuncNode, start, end int) *Graph {
+	g := &Graph{Func: funcNode, Start: start, End: end}
+	if start >= end {
+		return g
+	}
+
+	// Marcar los cuádruplos que inician un bloque: el primero, los destinos
+	// de salto y los que siguen a un salto o al fin de la función
+	lead := make([]bool, end-start+1)
+	lead[0] = true
+	for i := start; i < end; i++ {
+		switch quads[i].Operator {
+		case ast.GOTO, ast.GOTOF:
+			if target := quads[i].Result; target >= start && target < end {
+				lead[target-start] = true
+			}
+			lead[i+1-start] = true
+		case ast.ENDFUNC:
+			lead[i+1-start] = true
+		}
+	}
+
+	// Crear los bloques
+	blockOf := make([]*Block, end-start)
+	for i := start; i < end; i++ {
+		if lead[i-start] {
+			g.Blocks = append(g.Blocks, &Block{Index: len(g.Blocks), Start: i})
+		}
+		b := g.Blocks[len(g.Blocks)-1]
+		b.End = i + 1
+		blockOf[i-start] = b
+	}
+
+	// Conectar cada bloque con sus sucesores
+	link := func(from *Block, target int) {
+		if target < start || target >= end {
+			// Salto al final del programa
+			return
+		}
+		to := blockOf[target-start]
+		for _, s := range from.Succs {
+			if s == to {
+				return
+			}
+		}
+		from.Succs = append(from.Succs, to)
+		to.Preds = append(to.Preds, from)
+	}
+	for _, b := range g.Blocks {
+		last := quads[b.End-1]
+		switch last.Operator {
+		case ast.GOTO:
+			link(b, last.Result)
+		case ast.GOTOF:
+			link(b, b.End)
+			link(b, last.Result)
+		case ast.ENDFUNC:
+		default:
+			link(b, b.End)
+		}
+	}
+
+	g.computeDominators()
+	g.findLoops()
+	return g
+}
+
+// Obtiene el bloque de entrada (nil si la función no tiene cuádruplos)
+func (g *Graph) Entry() *Block {
+	if len(g.Blocks) == 0 {
+		return nil
+	}
+	return g.Blocks[0]
+}
+
+// Indica si el bloque a domina al bloque b: todo camino desde la entrada
+// hasta b pasa por a
+func (g *Graph) Dominates(a, b *Block) bool {
+	if !a.Reachable || !b.Reachable {
+		return false
+	}
+	for ; b != nil; b = b.Idom {
+		if b == a {
+			return true
+		}
+	}
+	return false
+}
+
+// Calcula los bloques alcanzables y el dominador inmediato de cada uno con
+// el algoritmo iterativo de Cooper, Harvey y Kennedy
+func (g *Graph) computeDominators() {
+	entry := g.Entry()
+	if entry == nil {
+		return
+	}
+
+	// Ordenar los bloques alcanzables en postorden
+	var postorder []*Block
+	var visit func(b *Block)
+	visit = func(b *Block) {
+		b.Reachable = true
+		for _, s := range b.Succs {
+			if !s.Reachable {
+				visit(s)
+			}
+		}
+		postorder = append(postorder, b)
+	}
+	visit(entry)
+	order := make([]int, len(g.Blocks))
+	for i, b := range postorder {
+		order[b.Index] = i
+	}
+
+	// La entrada es su propio dominador durante el cálculo
+	idom := make([]*Block, len(g.Blocks))
+	idom[entry.Index] = entry
+	intersect := func(a, b *Block) *Block {
+		for a != b {
+			for order[a.Index] < order[b.Index] {
+				a = idom[a.Index]
+			}
+			for order[b.Index] < order[a.Index] {
+				b = idom[b.Index]
+			}
+		}
+		return a
+	}
+
+	// Recorrer en postorden inverso hasta que no haya cambios
+	for changed := true; changed; {
+		changed = false
+		for i := len(postorder) - 2; i >= 0; i-- {
+			b := postorder[i]
+			var newIdom *Block
+			for _, p := range b.Preds {
+				if idom[p.Index] == nil {
+					continue
+				}
+				if newIdom == nil {
+					newIdom = p
+				} else {
+					newIdom = intersect(p, newIdom)
+				}
+			}
+			if idom[b.Index] != newIdom {
+				idom[b.Index] = newIdom
+				changed = true
+			}
+		}
+	}
+
+	for _, b := range postorder {
+		if b != entry {
+			b.Idom = idom[b.Index]
+		}
+	}
+}
+
+// Encuentra los ciclos naturales a partir de las aristas que regresan a un
+// bloque que domina a su origen; los ciclos con el mismo encabezado se unen
+func (g *Graph) findLoops() {
+	loops := map[*Block]map[*Block]bool{}
+	for _, b := range g.Blocks {
+		for _, h := range b.Succs {
+			if !g.Dominates(h, b) {
+				continue
+			}
+
+			// Agregar los bloques que llegan a b sin pasar por el encabezado
+			body := loops[h]
+			if body == nil {
+				body = map[*Block]bool{h: true}
+				loops[h] = body
+			}
+			work := []*Block{b}
+			for len(work) > 0 {
+				n := work[len(work)-1]
+				work = work[:len(work)-1]
+				if body[n] || !n.Reachable {
+					continue
+				}
+				body[n] = true
+				work = append(work, n.Preds...)
+			}
+		}
+	}
+
+	for _, h := range g.Blocks {
+		body, ok := loops[h]
+		if !ok {
+			continue
+		}
+		loop := &Loop{Header: h}
+		for _, b := range g.Blocks {
+			if body[b] {
+				loop.Blocks = append(loop.Blocks, b)
+			}
+		}
+		g.Loops = append(g.Loops, loop)
+	}
+}
@@ -0,0 +1,78 @@
+package cfg
+
+import (
+	"BabyDuck/ast"
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Escribe los grafos en formato DOT de Graphviz; cada función es un
+// subgrafo cuyos nodos muestran los cuádruplos de cada bloque. Los
+// encabezados de ciclo tienen doble borde y los bloques inalcanzables se
+// muestran en gris
+func WriteDOT(w io.Writer, quads []ast.Quadruple, graphs []*Graph) error {
+	out := bufio.NewWriter(w)
+
+	fmt.Fprintln(out, "digraph cfg {")
+	fmt.Fprintln(out, "  node [shape=box, fontname=\"monospace\"];")
+	for _, g := range graphs {
+		fmt.Fprintf(out, "  subgraph \"cluster_%s\" {\n", g.Func.Id)
+		fmt.Fprintf(out, "    label=%s;\n", quote(g.Func.Id))
+
+		// Marcar los encabezados de ciclo
+		headers := map[*Block]bool{}
+		for _, loop := range g.Loops {
+			headers[loop.Header] = true
+		}
+
+		// Un nodo por bloque con sus cuádruplos alineados a la izquierda
+		for _, b := range g.Blocks {
+			var label strings.Builder
+			fmt.Fprintf(&label, "B%d\n", b.Index)
+			for i := b.Start; i < b.End; i++ {
+				fmt.Fprintf(&label, "%d: %s\n", i, quads[i])
+			}
+
+			attrs := []string{"label=" + quote(label.String())}
+			if headers[b] {
+				attrs = append(attrs, "peripheries=2")
+			}
+			if !b.Reachable {
+				attrs = append(attrs, "color=gray", "fontcolor=gray")
+			}
+			fmt.Fprintf(out, "    %s [%s];\n", nodeName(g, b), strings.Join(attrs, ", "))
+		}
+
+		// Las aristas de salto de un GOTOF se etiquetan como falsas
+		for _, b := range g.Blocks {
+			last := quads[b.End-1]
+			for _, s := range b.Succs {
+				attr := ""
+				if last.Operator == ast.GOTOF && s.Start == last.Result && s.Start != b.End {
+					attr = " [label=\"F\"]"
+				}
+				fmt.Fprintf(out, "    %s -> %s%s;\n", nodeName(g, b), nodeName(g, s), attr)
+			}
+		}
+		fmt.Fprintln(out, "  }")
+	}
+	fmt.Fprintln(out, "}")
+
+	return out.Flush()
+}
+
+// Obtiene el nombre único del nodo de un bloque
+func nodeName(g *Graph, b *Block) string {
+	return quote(fmt.Sprintf("%s.B%d", g.Func.Id, b.Index))
+}
+
+// Escribe un texto como cadena de DOT; los saltos de línea alinean el
+// texto a la izquierda
+func quote(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `"`, `\"`)
+	s = strings.ReplaceAll(s, "\n", `\l`)
+	return `"` + s + `"`
+}
@@ -0,0 +1,220 @@
+package main
+
+import (
+	"BabyDuck/ast"
+	"BabyDuck/cfg"
+	parseError "BabyDuck/errors"
+	"BabyDuck/lexer"
+	"BabyDuck/opt"
+	"BabyDuck/parser"
+	"BabyDuck/token"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+)
+
+// Códigos de salida del compilador
+const (
+	exitOK       = 0
+	exitUsage    = 1
+	exitLexical  = 2
+	exitSyntax   = 3
+	exitSemantic = 4
+	exitRuntime  = 5
+)
+
+// Subcomandos disponibles y su descripción
+var commands = []struct {
+	Name        string
+	Description string
+}{
+	{"run", "compila y ejecuta el programa"},
+	{"check", "verifica la sintaxis y la semántica sin ejecutar"},
+	{"quads", "imprime los cuádruplos generados"},
+	{"symbols", "imprime el directorio de funciones y las tablas de variables"},
+	{"cfg", "imprime el grafo de flujo de control en formato DOT"},
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+// Imprime las instrucciones de uso
+func usage(stderr io.Writer) {
+	fmt.Fprintln(stderr, "uso: babyduck <comando> [opciones] archivo.bbd")
+	fmt.Fprintln(stderr)
+	fmt.Fprintln(stderr, "Comandos:")
+	for _, c := range commands {
+		fmt.Fprintf(stderr, "  %-8s %s\n", c.Name, c.Description)
+	}
+	fmt.Fprintln(stderr)
+	fmt.Fprintln(stderr, "Opciones:")
+	fmt.Fprintln(stderr, "  -O             optimiza el código intermedio antes de imprimirlo o ejecutarlo")
+	fmt.Fprintf(stderr, "  -max-errors n  máximo de errores semánticos a reportar (0 = sin límite, por omisión %d)\n", ast.DefaultErrorLimit)
+	fmt.Fprintln(stderr, "  -overflow m    desbordamiento entero: wrap (trunca a 64 bits, por omisión) o trap (error de ejecución)")
+	fmt.Fprintln(stderr, "  -strict-float  detiene la ejecución ante resultados flotantes NaN o infinitos")
+	fmt.Fprintf(stderr, "  -max-depth n   máximo de llamadas activas (0 = sin límite, por omisión %d)\n", ast.DefaultMaxDepth)
+	fmt.Fprintf(stderr, "  -max-memory n  máximo de valores en la pila de registros (0 = sin límite, por omisión %d)\n", ast.DefaultMaxMemory)
+	fmt.Fprintln(stderr, "  -max-instructions n  máximo de cuádruplos a ejecutar (0 = sin límite)")
+	fmt.Fprintln(stderr, "  -timeout d     tiempo máximo de ejecución, p. ej. 2s (0 = sin límite)")
+}
+
+// Ejecuta el subcomando indicado y devuelve el código de salida; la salida
+// del programa y los reportes se escriben en stdout y los errores en stderr
+func run(args []string, stdout, stderr io.Writer) int {
+	if len(args) < 1 {
+		usage(stderr)
+		return exitUsage
+	}
+
+	// Verificar que el subcomando exista
+	cmd := args[0]
+	valid := false
+	for _, c := range commands {
+		if c.Name == cmd {
+			valid = true
+		}
+	}
+	if !valid {
+		fmt.Fprintf(stderr, "babyduck: comando desconocido '%s'\n", cmd)
+		usage(stderr)
+		return exitUsage
+	}
+
+	// Leer las opciones del subcomando
+	fs := flag.NewFlagSet(cmd, flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.Usage = func() { usage(stderr) }
+	optimize := fs.Bool("O", false, "optimiza el código intermedio")
+	maxErrors := fs.Int("max-errors", ast.DefaultErrorLimit, "máximo de errores semánticos a reportar")
+	overflow := fs.String("overflow", "wrap", "desbordamiento entero: wrap o trap")
+	strictFloat := fs.Bool("strict-float", false, "detiene la ejecución ante resultados flotantes NaN o infinitos")
+	maxDepth := fs.Int("max-depth", ast.DefaultMaxDepth, "máximo de llamadas activas")
+	maxMemory := fs.Int("max-memory", ast.DefaultMaxMemory, "máximo de valores en la pila de registros")
+	maxInstructions := fs.Int("max-instructions", 0, "máximo de cuádruplos a ejecutar")
+	timeout := fs.Duration("timeout", 0, "tiempo máximo de ejecución")
+	if err := fs.Parse(args[1:]); err != nil {
+		return exitUsage
+	}
+
+	// Verificar el modo de desbordamiento
+	overflowModes := map[string]ast.OverflowMode{"wrap": ast.OverflowWrap, "trap": ast.OverflowTrap}
+	overflowMode, ok := overflowModes[*overflow]
+	if !ok {
+		fmt.Fprintf(stderr, "babyduck: modo de desbordamiento desconocido '%s'\n", *overflow)
+		usage(stderr)
+		return exitUsage
+	}
+	if fs.NArg() != 1 {
+		usage(stderr)
+		return exitUsage
+	}
+	path := fs.Arg(0)
+
+	// Compilar el programa
+	ct, code := compile(path, *maxErrors, stderr)
+	if code != exitOK {
+		return code
+	}
+
+	// Optimizar el código intermedio si se indicó
+	var stats opt.Stats
+	if *optimize {
+		var err error
+		if stats, err = opt.Optimize(ct); err != nil {
+			fmt.Fprintln(stderr, err)
+			return exitSemantic
+		}
+	}
+
+	switch cmd {
+	case "quads":
+		ct.PrintQuads(stdout)
+		if *optimize {
+			fmt.Fprintf(stdout, "\nOptimización: %s\n", stats)
+		}
+	case "symbols":
+		ct.PrintSymbols(stdout)
+	case "cfg":
+		if err := cfg.WriteDOT(stdout, ct.Quads, cfg.Build(ct)); err != nil {
+			fmt.Fprintf(stderr, "babyduck: %v\n", err)
+			return exitUsage
+		}
+	case "run":
+		rt := ast.NewRuntime(ct)
+		rt.Out = stdout
+		rt.Overflow = overflowMode
+		rt.StrictFloat = *strictFloat
+		rt.MaxDepth = *maxDepth
+		rt.MaxMemory = *maxMemory
+		rt.MaxInstructions = *maxInstructions
+
+		// Limitar el tiempo de ejecución si se indicó
+		ctx := context.Background()
+		if *timeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, *timeout)
+			defer cancel()
+		}
+		// La salida se escribe en stdout conforme se ejecuta el programa
+		if err := rt.RunProgram(ctx); err != nil {
+			fmt.Fprintln(stderr, err)
+			return exitRuntime
+		}
+	}
+
+	return exitOK
+}
+
+// Analiza y genera el código intermedio de un archivo fuente
+func compile(path string, maxErrors int, stderr io.Writer) (*ast.Compilation, int) {
+	// Analizar el léxico del archivo fuente
+	s, err := lexer.NewLexerFile(path)
+	if err != nil {
+		fmt.Fprintf(stderr, "babyduck: %v\n", err)
+		return nil, exitUsage
+	}
+
+	// Analizar la sintaxis del código fuente
+	p := parser.NewParser()
+	program, err := p.Parse(s)
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return nil, parseExitCode(err)
+	}
+
+	// Generar el código intermedio
+	ct := ast.NewCompilation(ast.NewCompiler())
+	ct.Diags.Limit = maxErrors
+	if err := program.(*ast.ProgramNode).Generate(ct); err != nil {
+		fmt.Fprintln(stderr, err)
+		return nil, exitSemantic
+	}
+
+	// Imprimir las advertencias de una compilación exitosa
+	for _, diag := range ct.Diags.Sorted() {
+		fmt.Fprintln(stderr, diag)
+	}
+
+	return ct, exitOK
+}
+
+// Clasifica un error del analizador según la fase en la que ocurrió
+func parseExitCode(err error) int {
+	var perr *parseError.Error
+	if !errors.As(err, &perr) {
+		return exitSyntax
+	}
+	switch {
+	case perr.ErrorToken.Type == token.INVALID:
+		// El lexer no reconoció el token
+		return exitLexical
+	case perr.Err != nil:
+		// Error reportado por una acción semántica de la gramática
+		return exitSemantic
+	}
+	return exitSyntax
+}
@@ -0,0 +1,42 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+// Verifica el código de salida de cada fase del compilador
+func TestExitCodes(t *testing.T) {
+	cases := []struct {
+		Name   string
+		Args   []string
+		Code   int
+		Stdout string // Texto que debe aparecer en la salida estándar
+	}{
+		{Name: "sin argumentos", Args: nil, Code: exitUsage},
+		{Name: "comando desconocido", Args: []string{"compile", "../../tests/pass/factorial.bbd"}, Code: exitUsage},
+		{Name: "opción desconocida", Args: []string{"run", "-fast", "../../tests/pass/factorial.bbd"}, Code: exitUsage},
+		{Name: "archivo inexistente", Args: []string{"run", "../../tests/pass/noexiste.bbd"}, Code: exitUsage},
+		{Name: "error léxico", Args: []string{"check", "../../tests/fail/lexical.bbd"}, Code: exitLexical},
+		{Name: "error sintáctico", Args: []string{"check", "../../tests/fail/syntax.bbd"}, Code: exitSyntax},
+		{Name: "error semántico", Args: []string{"check", "../../tests/fail/semantics.bbd"}, Code: exitSemantic},
+		{Name: "error de ejecución", Args: []string{"run", "../../tests/fail/shadowing.bbd"}, Code: exitRuntime},
+		{Name: "ejecución exitosa", Args: []string{"run", "../../tests/pass/factorial.bbd"}, Code: exitOK, Stdout: "El factorial es: 120"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			var stdout, stderr strings.Builder
+			code := run(tc.Args, &stdout, &stderr)
+			if code != tc.Code {
+				t.Errorf("se esperaba el código %d, se obtuvo %d:\n%s", tc.Code, code, stderr.String())
+			}
+			if !strings.Contains(stdout.String(), tc.Stdout) {
+				t.Errorf("se esperaba %q en la salida, se obtuvo %q", tc.Stdout, stdout.String())
+			}
+			if code != exitOK && stderr.Len() == 0 {
+				t.Errorf("no se reportó el error")
+			}
+		})
+	}
+}
@@ -2,19 +2,33 @@ package main
 
 import (
 	"BabyDuck/ast"
+	"BabyDuck/cfg"
 	"BabyDuck/lexer"
+	"BabyDuck/opt"
 	"BabyDuck/parser"
+	"context"
+	"errors"
+	"io"
 	"os"
+	"path/filepath"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 )
 
 // Define los casos de prueba
 type TestCase struct {
 	Name   string
 	Source string
+	Input  string  // Entrada del programa, tomada del archivo .in del mismo nombre
+	Output *string // Salida esperada, tomada del archivo .out del mismo nombre (nil si no existe)
 	Expect bool
 }
 
+// Máximo de cuádruplos que ejecuta cada caso de prueba
+const testMaxInstructions = 1_000_000
+
 // Devuelve el contenido de un archivo como texto
 func ReadTestCase(filename string) string {
 	lines, err := os.ReadFile(filename)
@@ -28,16 +42,27 @@ func ReadTestCase(filename string) string {
 func NewTestCases() []TestCase {
 	var testCases []TestCase
 
-	passCases, _ := os.ReadDir("tests/pass")
-	for _, file := range passCases {
-		source := ReadTestCase("tests/pass/" + file.Name())
-		testCases = append(testCases, TestCase{Name: file.Name(), Source: source, Expect: true})
-	}
+	for _, dir := range []string{"tests/pass", "tests/fail"} {
+		files, _ := filepath.Glob(dir + "/*.bbd")
+		for _, file := range files {
+			testCase := TestCase{
+				Name:   filepath.Base(file),
+				Source: ReadTestCase(file),
+				Expect: dir == "tests/pass",
+			}
 
-	failCases, _ := os.ReadDir("tests/fail")
-	for _, file := range failCases {
-		source := ReadTestCase("tests/fail/" + file.Name())
-		testCases = append(testCases, TestCase{Name: file.Name(), Source: source, Expect: false})
+			// Agregar la entrada del programa si existe
+			if input, err := os.ReadFile(strings.TrimSuffix(file, ".bbd") + ".in"); err == nil {
+				testCase.Input = string(input)
+			}
+
+			// Agregar la salida esperada si existe
+			if output, err := os.ReadFile(strings.TrimSuffix(file, ".bbd") + ".out"); err == nil {
+				expected := string(output)
+				testCase.Output = &expected
+			}
+			testCases = append(testCases, testCase)
+		}
 	}
 
 	return testCases
@@ -57,11 +82,36 @@ func VerifyOutcome(t *testing.T, err error, expect bool) bool {
 	return false
 }
 
+// Analiza un programa; falla la prueba si tiene errores léxicos o sintácticos
+func parse(tb testing.TB, source string) *ast.ProgramNode {
+	tb.Helper()
+	s := lexer.NewLexer([]byte(source))
+	program, err := parser.NewParser().Parse(s)
+	if err != nil {
+		tb.Fatal(err)
+	}
+	return program.(*ast.ProgramNode)
+}
+
+// Analiza un programa y genera su código intermedio; falla la prueba si la
+// compilación produce algún error
+func compile(tb testing.TB, source string) *ast.Compilation {
+	tb.Helper()
+	ct := ast.NewCompilation(ast.NewCompiler())
+	if err := parse(tb, source).Generate(ct); err != nil {
+		tb.Fatal(err)
+	}
+	return ct
+}
+
 func TestCompiler(t *testing.T) {
 	testCases := NewTestCases()
 
 	for _, tc := range testCases {
 		t.Run(tc.Name, func(t *testing.T) {
+			// Cada caso usa su propio compilador, por lo que pueden ejecutarse en paralelo
+			t.Parallel()
+
 			// Analizar el léxico del código fuente
 			s := lexer.NewLexer([]byte(tc.Source))
 			p := parser.NewParser()
@@ -75,15 +125,30 @@ func TestCompiler(t *testing.T) {
 			}
 
 			// Si el análisis fue exitoso, generar el código intermedio
-			ct := &ast.Compilation{}
-			err = program.(ast.ProgramNode).Generate(ct)
+			ct := ast.NewCompilation(ast.NewCompiler())
+			err = program.(*ast.ProgramNode).Generate(ct)
 
 			// Verificar si hubo errores al generar el código intermedio
-			VerifyOutcome(t, err, tc.Expect)
+			if next := VerifyOutcome(t, err, tc.Expect); next {
+				return
+			}
+
+			// Registrar las tablas de variables y los cuádruplos generados
+			var dump strings.Builder
+			ct.PrintSymbols(&dump)
+			ct.PrintQuads(&dump)
+			t.Log(dump.String())
 
-			// Ejecutar el programa con el código generado
+			// Ejecutar el programa con el código generado; el límite de
+			// instrucciones detiene los casos que no terminan y el tiempo
+			// límite queda como respaldo
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
 			rt := ast.NewRuntime(ct)
-			err = rt.RunProgram()
+			rt.MaxInstructions = testMaxInstructions
+			rt.In = strings.NewReader(tc.Input)
+			output := rt.Capture()
+			err = rt.RunProgram(ctx)
 
 			// Verificar si hubo errores al ejecutar el programa
 			VerifyOutcome(t, err, tc.Expect)
@@ -94,9 +159,598 @@ func TestCompiler(t *testing.T) {
 				t.FailNow()
 			}
 
-			// Imprimir la salida del programa
-			rt.PrintOutput()
-			rt.Clear()
+			// Registrar la salida del programa y compararla con la esperada
+			t.Log(output.String())
+			if tc.Output != nil && output.String() != *tc.Output {
+				t.Errorf("salida inesperada:\n%s\nse esperaba:\n%s", output, *tc.Output)
+			}
 		})
 	}
 }
+
+// Compila y ejecuta el mismo programa en varias gorutinas a la vez
+func TestConcurrentCompilations(t *testing.T) {
+	source := ReadTestCase("tests/pass/fibonacci.bbd")
+
+	// Compila y ejecuta el programa y devuelve su salida
+	compileAndRun := func() (string, error) {
+		s := lexer.NewLexer([]byte(source))
+		p := parser.NewParser()
+		program, err := p.Parse(s)
+		if err != nil {
+			return "", err
+		}
+
+		ct := ast.NewCompilation(ast.NewCompiler())
+		if err := program.(*ast.ProgramNode).Generate(ct); err != nil {
+			return "", err
+		}
+
+		rt := ast.NewRuntime(ct)
+		output := rt.Capture()
+		if err := rt.RunProgram(context.Background()); err != nil {
+			return "", err
+		}
+		return output.String(), nil
+	}
+
+	expected, err := compileAndRun()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var wg sync.WaitGroup
+	outputs := make([]string, 8)
+	errs := make([]error, len(outputs))
+	for i := range outputs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			outputs[i], errs[i] = compileAndRun()
+		}()
+	}
+	wg.Wait()
+
+	for i := range outputs {
+		if errs[i] != nil {
+			t.Fatal(errs[i])
+		}
+		if outputs[i] != expected {
+			t.Errorf("salida distinta en la compilación %d:\n%s", i, outputs[i])
+		}
+	}
+}
+
+// Verifica que los errores semánticos y de ejecución indiquen su posición
+func TestErrorPositions(t *testing.T) {
+	cases := []struct {
+		Name   string
+		Source string
+		Expect string
+	}{
+		{
+			Name:   "variable no declarada",
+			Source: "program p;\nvar x: int;\nmain {\n    x = 1;\n    z = x + 2;\n}\nend",
+			Expect: "5:5: error: variable 'z' no declarada [E001]",
+		},
+		{
+			Name:   "tipos incompatibles",
+			Source: "program p;\nvar x: int;\nmain {\n    x = 1.5 * 2;\n}\nend",
+			Expect: "4:5: error: operación inválida entre float y int [E005]",
+		},
+		{
+			Name:   "variable no inicializada",
+			Source: "program p;\nvar x, y: int;\nmain {\n    x = 1;\n    print(x + y);\n}\nend",
+			Expect: "5:13: error: variable y no inicializada (cuádruplo 2, función p)",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			ct := ast.NewCompilation(ast.NewCompiler())
+			err := parse(t, tc.Source).Generate(ct)
+			if err == nil {
+				rt := ast.NewRuntime(ct)
+				rt.Capture()
+				err = rt.RunProgram(context.Background())
+			}
+
+			if err == nil || err.Error() != tc.Expect {
+				t.Errorf("se esperaba %q, se obtuvo %v", tc.Expect, err)
+			}
+		})
+	}
+}
+
+// Verifica que se reporten todos los errores semánticos ordenados y sin cascadas
+func TestDiagnostics(t *testing.T) {
+	source := "program p;\nvar x: int;\nmain {\n    x = a + 1;\n    print(b * c);\n    x = 2.5;\n    y = a;\n    switch (x) {\n        case 1:\n            print(1);\n        case 1.0:\n            print(2);\n    };\n}\nend"
+	expect := []string{
+		"4:9: error: variable 'a' no declarada [E001]",
+		"5:11: error: variable 'b' no declarada [E001]",
+		"5:15: error: variable 'c' no declarada [E001]",
+		"6:5: error: operación inválida entre float y int [E005]",
+		"7:5: error: variable 'y' no declarada [E001]",
+		"7:9: error: variable 'a' no declarada [E001]",
+		"11:9: error: valor 1.0 repetido en los casos del switch [E016]",
+	}
+
+	generate := func(limit int) *ast.DiagnosticError {
+		ct := ast.NewCompilation(ast.NewCompiler())
+		ct.Diags.Limit = limit
+		err := parse(t, source).Generate(ct)
+
+		var diagErr *ast.DiagnosticError
+		if !errors.As(err, &diagErr) {
+			t.Fatalf("se esperaban diagnósticos, se obtuvo %v", err)
+		}
+		return diagErr
+	}
+
+	// Todos los errores deben reportarse en orden de posición
+	diagErr := generate(ast.DefaultErrorLimit)
+	if len(diagErr.List) != len(expect) {
+		t.Fatalf("se esperaban %d diagnósticos, se obtuvo:\n%v", len(expect), diagErr)
+	}
+	for i, diag := range diagErr.List {
+		if diag.Error() != expect[i] {
+			t.Errorf("diagnóstico %d: se esperaba %q, se obtuvo %q", i, expect[i], diag.Error())
+		}
+	}
+
+	// El análisis debe detenerse al alcanzar el límite
+	diagErr = generate(2)
+	if len(diagErr.List) != 2 || !diagErr.Truncated {
+		t.Errorf("se esperaban 2 diagnósticos truncados, se obtuvo:\n%v", diagErr)
+	}
+}
+
+// Verifica que los arreglos que no caben en un segmento se rechacen sin
+// desbordar el cálculo de su tamaño
+func TestArrayLimits(t *testing.T) {
+	source := "program p;\nvar a: int[3037000500][3037000500];\n    b: float[10][101];\nmain {\n    a[0][0] = 1;\n}\nend"
+	expect := []string{
+		"2:5: error: el arreglo 'a' excede el máximo de 1000 elementos [E013]",
+		"3:5: error: el arreglo 'b' excede el máximo de 1000 elementos [E013]",
+	}
+
+	ct := ast.NewCompilation(ast.NewCompiler())
+	err := parse(t, source).Generate(ct)
+
+	var diagErr *ast.DiagnosticError
+	if !errors.As(err, &diagErr) {
+		t.Fatalf("se esperaban diagnósticos, se obtuvo %v", err)
+	}
+	if len(diagErr.List) != len(expect) {
+		t.Fatalf("se esperaban %d diagnósticos, se obtuvo:\n%v", len(expect), diagErr)
+	}
+	for i, diag := range diagErr.List {
+		if diag.Error() != expect[i] {
+			t.Errorf("diagnóstico %d: se esperaba %q, se obtuvo %q", i, expect[i], diag.Error())
+		}
+	}
+
+	// El asignador no debe reservar tamaños inválidos
+	alloc := ast.NewAllocator()
+	if _, err := alloc.NextGlobal("int", -1); err == nil {
+		t.Errorf("se esperaba un error al reservar un tamaño negativo")
+	}
+	if _, err := alloc.NextLocal("float", 0); err == nil {
+		t.Errorf("se esperaba un error al reservar un tamaño cero")
+	}
+}
+
+// Verifica que el verificador anote los tipos y símbolos sin generar código
+func TestCheckerAnnotations(t *testing.T) {
+	source := "program p;\nvar x: int; y: float;\nmain {\n    y = x * 2.5;\n}\nend"
+
+	programNode := parse(t, source)
+	if err := programNode.Check(ast.NewChecker(&ast.Diagnostics{})); err != nil {
+		t.Fatal(err)
+	}
+
+	// La asignación debe resolver la variable destino
+	assign := programNode.Body[0].(*ast.AssignNode)
+	if assign.Symbol != programNode.Vars[1] {
+		t.Errorf("se esperaba el símbolo de 'y', se obtuvo %v", assign.Symbol)
+	}
+
+	// La expresión debe tener el tipo resultante y sus variables su declaración
+	exp := assign.Exp.(*ast.ExpressionNode)
+	if ast.TypeOf(exp) != "float" {
+		t.Errorf("se esperaba tipo float, se obtuvo %q", ast.TypeOf(exp))
+	}
+	if left := exp.Left.(*ast.ExpressionVar); left.Symbol != programNode.Vars[0] || left.Type != "int" {
+		t.Errorf("variable 'x' mal resuelta: %+v", left)
+	}
+}
+
+// Verifica la aritmética exacta entre enteros y el formato de los flotantes
+func TestTypedValues(t *testing.T) {
+	source := "program p;\nvar x: int; y: float;\nmain {\n    x = 3037000499 * 3037000499;\n    print(x, 7 / 2, -7 / 2);\n    y = 35.0 / 2;\n    print(y, y * 2);\n}\nend"
+
+	ct := compile(t, source)
+
+	rt := ast.NewRuntime(ct)
+	output := rt.Capture()
+	if err := rt.RunProgram(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "9223372030926249001 3 -3\n17.5 35.0\n"
+	if output.String() != expected {
+		t.Errorf("se esperaba %q, se obtuvo %q", expected, output)
+	}
+}
+
+// Verifica que los errores de ejecución indiquen su causa, cuádruplo y función
+func TestRuntimeErrors(t *testing.T) {
+	source := "program p;\nvar a: int; f: float;\nfloat div(x: float, y: float) [{\n    return x / y;\n}];\nmain {\n    f = div(1.0, 0.0);\n    print(f);\n    a = 0;\n    print(1 / a);\n}\nend"
+
+	ct := compile(t, source)
+
+	// Sin modo estricto la división flotante entre cero produce +Inf y la
+	// división entera entre cero es un error del cuerpo principal
+	rt := ast.NewRuntime(ct)
+	output := rt.Capture()
+	err := rt.RunProgram(context.Background())
+	var rtErr *ast.RuntimeError
+	if !errors.As(err, &rtErr) || !errors.Is(err, ast.ErrDivisionByZero) {
+		t.Fatalf("se esperaba una división entre cero, se obtuvo %v", err)
+	}
+	if rtErr.Func != "p" || rtErr.Pos.Line != 10 || ct.Quads[rtErr.IP].Operator != ast.DIVIDE {
+		t.Errorf("error mal ubicado: %+v", rtErr)
+	}
+	if output.String() != "+Inf\n" {
+		t.Errorf("se esperaba +Inf, se obtuvo %q", output)
+	}
+
+	// En modo estricto la división flotante falla dentro de la función
+	rt = ast.NewRuntime(ct)
+	rt.Capture()
+	rt.StrictFloat = true
+	err = rt.RunProgram(context.Background())
+	if !errors.As(err, &rtErr) || !errors.Is(err, ast.ErrDivisionByZero) || rtErr.Func != "div" || rtErr.Pos.Line != 4 {
+		t.Errorf("se esperaba una división entre cero en div, se obtuvo %v", err)
+	}
+}
+
+// Verifica los límites de profundidad y memoria de la pila de llamadas
+func TestStackOverflow(t *testing.T) {
+	source := ReadTestCase("tests/fail/overflow.bbd")
+
+	ct := compile(t, source)
+
+	// Límite de profundidad
+	rt := ast.NewRuntime(ct)
+	rt.Capture()
+	rt.MaxDepth = 50
+	var overflow *ast.StackOverflowError
+	if err := rt.RunProgram(context.Background()); !errors.As(err, &overflow) {
+		t.Fatalf("se esperaba un desbordamiento de pila, se obtuvo %v", err)
+	}
+	if overflow.Depth != 50 || len(overflow.Frames) != ast.StackTraceFrames || overflow.Frames[0].Func != "forever" {
+		t.Errorf("desbordamiento mal reportado: %+v", overflow)
+	}
+
+	// Límite de memoria; cada contexto de forever ocupa 3 valores
+	rt = ast.NewRuntime(ct)
+	rt.Capture()
+	rt.MaxDepth = 0
+	rt.MaxMemory = 30
+	if err := rt.RunProgram(context.Background()); !errors.As(err, &overflow) || overflow.Depth >= 10 {
+		t.Errorf("se esperaba un desbordamiento por memoria, se obtuvo %v", err)
+	}
+}
+
+// Verifica que un ciclo infinito se detenga por límite de instrucciones o
+// por cancelación
+func TestRunLimits(t *testing.T) {
+	source := ReadTestCase("tests/fail/infinite.bbd")
+
+	ct := compile(t, source)
+
+	// Límite de instrucciones
+	rt := ast.NewRuntime(ct)
+	rt.Capture()
+	rt.MaxInstructions = 1000
+	if err := rt.RunProgram(context.Background()); !errors.Is(err, ast.ErrInstructionLimit) || rt.Instructions != 1001 {
+		t.Errorf("se esperaba el límite de instrucciones, se obtuvo %v tras %d cuádruplos", err, rt.Instructions)
+	}
+
+	// Tiempo límite
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	rt = ast.NewRuntime(ct)
+	rt.Capture()
+	if err := rt.RunProgram(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("se esperaba el vencimiento del contexto, se obtuvo %v", err)
+	}
+
+	// Tiempo límite mientras un read espera la entrada
+	ctx, cancel = context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	input, writer := io.Pipe()
+	defer writer.Close()
+	rt = ast.NewRuntime(compile(t, ReadTestCase("tests/pass/read.bbd")))
+	rt.Capture()
+	rt.In = input
+	var cancelErr *ast.CancelError
+	if err := rt.RunProgram(ctx); !errors.As(err, &cancelErr) || !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("se esperaba el vencimiento del contexto durante read, se obtuvo %v", err)
+	}
+
+	// Contexto cancelado antes de empezar
+	ctx, cancel = context.WithCancel(context.Background())
+	cancel()
+	rt = ast.NewRuntime(ct)
+	rt.Capture()
+	if err := rt.RunProgram(ctx); !errors.As(err, &cancelErr) || !errors.Is(err, context.Canceled) {
+		t.Errorf("se esperaba la cancelación, se obtuvo %v", err)
+	}
+}
+
+// Verifica los modos de desbordamiento entero
+func TestIntegerOverflow(t *testing.T) {
+	source := "program p;\nvar a: int;\nmain {\n    a = 9223372036854775807;\n    print(a + 1);\n}\nend"
+
+	ct := compile(t, source)
+
+	// Por omisión el resultado se trunca a 64 bits
+	rt := ast.NewRuntime(ct)
+	output := rt.Capture()
+	if err := rt.RunProgram(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if output.String() != "-9223372036854775808\n" {
+		t.Errorf("se esperaba el valor truncado, se obtuvo %q", output)
+	}
+
+	// Con OverflowTrap el desbordamiento es un error de ejecución
+	rt = ast.NewRuntime(ct)
+	rt.Capture()
+	rt.Overflow = ast.OverflowTrap
+	if err := rt.RunProgram(context.Background()); !errors.Is(err, ast.ErrIntOverflow) {
+		t.Errorf("se esperaba un error de desbordamiento, se obtuvo %v", err)
+	}
+}
+
+// Verifica que los programas optimizados produzcan la misma salida
+func TestOptimizedOutput(t *testing.T) {
+	// Compila y ejecuta un programa y devuelve su salida
+	compileAndRun := func(t *testing.T, tc TestCase, optimize bool) (string, error) {
+		ct := compile(t, tc.Source)
+		if optimize {
+			if _, err := opt.Optimize(ct); err != nil {
+				return "", err
+			}
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		rt := ast.NewRuntime(ct)
+		rt.MaxInstructions = testMaxInstructions
+		rt.In = strings.NewReader(tc.Input)
+		output := rt.Capture()
+		err := rt.RunProgram(ctx)
+		return output.String(), err
+	}
+
+	for _, tc := range NewTestCases() {
+		if !tc.Expect {
+			continue
+		}
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+
+			expected, err := compileAndRun(t, tc, false)
+			if err != nil {
+				t.Fatal(err)
+			}
+			output, err := compileAndRun(t, tc, true)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if output != expected {
+				t.Errorf("salida distinta con optimización:\n%s\nse esperaba:\n%s", output, expected)
+			}
+		})
+	}
+}
+
+// Verifica que las operaciones entre constantes se evalúen al compilar
+func TestConstantFolding(t *testing.T) {
+	source := "program p;\nvar x, y: int;\nmain {\n    x = 4;\n    y = x * 1 + 0;\n    print(2 * 3 + 1, y);\n}\nend"
+
+	ct := compile(t, source)
+	stats, err := opt.Optimize(ct)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats.Folded != 2 || stats.Simplified != 2 || stats.Removed == 0 {
+		t.Errorf("resultado inesperado de la optimización: %s", stats)
+	}
+
+	// 2 * 3 + 1 debe imprimirse directamente desde la constante 7
+	for _, q := range ct.Quads {
+		if q.Operator == ast.TIMES || q.Operator == ast.PLUS {
+			t.Errorf("quedó una operación sin simplificar: %+v", q)
+		}
+	}
+
+	rt := ast.NewRuntime(ct)
+	output := rt.Capture()
+	if err := rt.RunProgram(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if output.String() != "7 4\n" {
+		t.Errorf("se esperaba \"7 4\", se obtuvo %q", output)
+	}
+}
+
+// Verifica que se eliminen el código inalcanzable y los saltos innecesarios
+func TestDeadCode(t *testing.T) {
+	source := "program p;\nint f(n: int) [{\n    if (n > 0) {\n        return 1;\n        print(n);\n    } else {\n        return 2;\n    };\n}];\nmain {\n    if (1 < 2) {\n        print(f(1));\n    };\n    while (false) do {\n        print(0);\n    };\n}\nend"
+
+	ct := compile(t, source)
+	before := len(ct.Quads)
+	stats, err := opt.Optimize(ct)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats.Removed != before-len(ct.Quads) {
+		t.Errorf("se reportaron %d cuádruplos eliminados, se eliminaron %d", stats.Removed, before-len(ct.Quads))
+	}
+
+	// Direcciones que solo deben aparecer en el código eliminado: las
+	// constantes booleanas, el parámetro de f y la constante 0
+	f := ct.Compiler.FuncDir["f"]
+	bools := ct.Compiler.Alloc.Const.Bool
+	zero, found := ct.Compiler.Memory.Const.FindConst("int", ast.IntValue(0))
+	if !found {
+		t.Fatal("no se encontró la constante 0")
+	}
+
+	for i, q := range ct.Quads {
+		switch q.Operator {
+		case ast.GOTO, ast.GOTOF:
+			// No deben quedar saltos al siguiente cuádruplo ni condiciones constantes
+			if q.Result == i+1 {
+				t.Errorf("cuádruplo %d: salto al cuádruplo siguiente", i)
+			}
+			if q.Operator == ast.GOTOF && q.Left >= bools.Start && q.Left <= bools.End {
+				t.Errorf("cuádruplo %d: GOTOF con una condición constante", i)
+			}
+		case ast.ENDFUNC:
+			// El ENDFUNC después de un return es inalcanzable
+			if i > 0 && ct.Quads[i-1].Operator == ast.ENDFUNC {
+				t.Errorf("cuádruplo %d: ENDFUNC inalcanzable", i)
+			}
+		case ast.ERA, ast.GOSUB:
+			// Las llamadas deben apuntar al nuevo inicio de la función
+			if q.Left != f.QuadStart {
+				t.Errorf("cuádruplo %d: llamada al cuádruplo %d, la función inicia en %d", i, q.Left, f.QuadStart)
+			}
+		case ast.PRINT:
+			// El cuerpo del while (false) y el print después del return se eliminan
+			if q.Left == f.Params[0].Address || q.Left == zero.Address {
+				t.Errorf("cuádruplo %d: print inalcanzable", i)
+			}
+		}
+	}
+
+	rt := ast.NewRuntime(ct)
+	output := rt.Capture()
+	if err := rt.RunProgram(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if output.String() != "1\n" {
+		t.Errorf("se esperaba \"1\", se obtuvo %q", output)
+	}
+}
+
+// Verifica los bloques básicos, los dominadores y los ciclos del grafo de
+// flujo de control
+func TestControlFlowGraph(t *testing.T) {
+	source := "program p;\nvar i, s: int;\nmain {\n    i = 0;\n    s = 0;\n    while (i < 3) do {\n        if (i == 1) {\n            s = s + i;\n        };\n        i = i + 1;\n    };\n    print(s);\n}\nend"
+
+	ct := compile(t, source)
+
+	graphs := cfg.Build(ct)
+	if len(graphs) != 1 {
+		t.Fatalf("se esperaba un grafo, se obtuvieron %d", len(graphs))
+	}
+	g := graphs[0]
+
+	// Entrada, condición del while, condición del if, then, incremento y print
+	if len(g.Blocks) != 6 {
+		t.Fatalf("se esperaban 6 bloques, se obtuvieron %d", len(g.Blocks))
+	}
+	for _, b := range g.Blocks {
+		if !b.Reachable || !g.Dominates(g.Entry(), b) {
+			t.Errorf("la entrada debe alcanzar y dominar al bloque B%d", b.Index)
+		}
+	}
+
+	// El ciclo del while contiene todo menos la entrada y el print
+	if len(g.Loops) != 1 {
+		t.Fatalf("se esperaba un ciclo, se obtuvieron %d", len(g.Loops))
+	}
+	loop := g.Loops[0]
+	if loop.Header != g.Blocks[1] || len(loop.Blocks) != 4 {
+		t.Errorf("ciclo inesperado: encabezado B%d con %d bloques", loop.Header.Index, len(loop.Blocks))
+	}
+
+	// El bloque después del if lo domina la condición del if, no el then
+	if g.Blocks[4].Idom != g.Blocks[2] {
+		t.Errorf("el dominador inmediato de B4 debe ser B2")
+	}
+	if len(g.Blocks[1].Preds) != 2 || len(g.Blocks[5].Succs) != 0 {
+		t.Errorf("aristas inesperadas en el grafo")
+	}
+
+	var dot strings.Builder
+	if err := cfg.WriteDOT(&dot, ct.Quads, graphs); err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"digraph cfg {", "\"p.B4\" -> \"p.B1\";", "peripheries=2"} {
+		if !strings.Contains(dot.String(), want) {
+			t.Errorf("la salida DOT no contiene %q:\n%s", want, dot.String())
+		}
+	}
+}
+
+// Mide la ejecución de una recursión al estilo de tests/pass/fibonacci.bbd
+func BenchmarkFibonacci(b *testing.B) {
+	source := "program bench;\nvar r: int;\nint fib(n: int) [{\n    if (n < 2) {\n        return n;\n    } else {\n        return fib(n - 1) + fib(n - 2);\n    };\n}];\nmain {\n    r = fib(18);\n    print(r);\n}\nend"
+
+	ct := compile(b, source)
+
+	for b.Loop() {
+		rt := ast.NewRuntime(ct)
+		rt.Out = io.Discard
+		if err := rt.RunProgram(context.Background()); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// Verifica que los cálculos repetidos se reutilicen y que el resultado de
+// una llamada se lea sin copiarlo a un temporal
+func TestCommonSubexpressions(t *testing.T) {
+	source := "program p;\nvar a, b: int;\nint f(n: int) [{\n    return n * 2;\n}];\nmain {\n    a = 3;\n    b = 4;\n    print(a * b + b * a, f(a) + 1);\n}\nend"
+
+	ct := compile(t, source)
+	stats, err := opt.Optimize(ct)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats.Eliminated != 1 || stats.Removed == 0 {
+		t.Errorf("resultado inesperado de la optimización: %s", stats)
+	}
+
+	// Una multiplicación en f y una en el cuerpo principal; el valor de
+	// retorno de f se suma directamente
+	times := 0
+	returnAddress := ct.Compiler.FuncDir["f"].ReturnAddress
+	for _, q := range ct.Quads {
+		if q.Operator == ast.TIMES {
+			times++
+		}
+		if q.Operator == ast.ASSIGN && q.Left == returnAddress {
+			t.Errorf("el valor de retorno se copió a un temporal: %s", q)
+		}
+	}
+	if times != 2 {
+		t.Errorf("se esperaban 2 multiplicaciones, quedaron %d", times)
+	}
+
+	rt := ast.NewRuntime(ct)
+	output := rt.Capture()
+	if err := rt.RunProgram(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if output.String() != "24 7\n" {
+		t.Errorf("se esperaba \"24 7\", se obtuvo %q", output)
+	}
+}
@@ -29,7 +29,7 @@ var ActTab = ActionTable{
 		Ignore: "!whitespace",
 	},
 	ActionRow{ // S2
-		Accept: 0,
+		Accept: 29,
 		Ignore: "",
 	},
 	ActionRow{ // S3
@@ -37,71 +37,71 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S4
-		Accept: 13,
+		Accept: 40,
 		Ignore: "",
 	},
 	ActionRow{ // S5
-		Accept: 14,
+		Accept: 0,
 		Ignore: "",
 	},
 	ActionRow{ // S6
-		Accept: 26,
+		Accept: 18,
 		Ignore: "",
 	},
 	ActionRow{ // S7
-		Accept: 24,
+		Accept: 19,
 		Ignore: "",
 	},
 	ActionRow{ // S8
-		Accept: 10,
+		Accept: 38,
 		Ignore: "",
 	},
 	ActionRow{ // S9
-		Accept: 25,
+		Accept: 36,
 		Ignore: "",
 	},
 	ActionRow{ // S10
-		Accept: 27,
+		Accept: 13,
 		Ignore: "",
 	},
 	ActionRow{ // S11
-		Accept: 28,
+		Accept: 37,
 		Ignore: "",
 	},
 	ActionRow{ // S12
-		Accept: 9,
+		Accept: 39,
 		Ignore: "",
 	},
 	ActionRow{ // S13
-		Accept: 4,
+		Accept: 11,
 		Ignore: "",
 	},
 	ActionRow{ // S14
-		Accept: 22,
+		Accept: 9,
 		Ignore: "",
 	},
 	ActionRow{ // S15
-		Accept: 20,
+		Accept: 4,
 		Ignore: "",
 	},
 	ActionRow{ // S16
-		Accept: 21,
+		Accept: 31,
 		Ignore: "",
 	},
 	ActionRow{ // S17
-		Accept: 15,
+		Accept: 23,
 		Ignore: "",
 	},
 	ActionRow{ // S18
-		Accept: 16,
+		Accept: 30,
 		Ignore: "",
 	},
 	ActionRow{ // S19
-		Accept: 3,
+		Accept: 10,
 		Ignore: "",
 	},
 	ActionRow{ // S20
-		Accept: 3,
+		Accept: 12,
 		Ignore: "",
 	},
 	ActionRow{ // S21
@@ -137,135 +137,135 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S29
-		Accept: 18,
+		Accept: 3,
 		Ignore: "",
 	},
 	ActionRow{ // S30
-		Accept: 19,
+		Accept: 3,
 		Ignore: "",
 	},
 	ActionRow{ // S31
-		Accept: 23,
+		Accept: 3,
 		Ignore: "",
 	},
 	ActionRow{ // S32
-		Accept: 0,
+		Accept: 3,
 		Ignore: "",
 	},
 	ActionRow{ // S33
-		Accept: 35,
+		Accept: 3,
 		Ignore: "",
 	},
 	ActionRow{ // S34
-		Accept: 0,
+		Accept: 3,
 		Ignore: "",
 	},
 	ActionRow{ // S35
-		Accept: 0,
+		Accept: 3,
 		Ignore: "",
 	},
 	ActionRow{ // S36
-		Accept: 0,
+		Accept: 3,
 		Ignore: "",
 	},
 	ActionRow{ // S37
-		Accept: 0,
+		Accept: 3,
 		Ignore: "",
 	},
 	ActionRow{ // S38
-		Accept: 0,
+		Accept: 3,
 		Ignore: "",
 	},
 	ActionRow{ // S39
-		Accept: 0,
+		Accept: 21,
 		Ignore: "",
 	},
 	ActionRow{ // S40
-		Accept: 3,
+		Accept: 0,
 		Ignore: "",
 	},
 	ActionRow{ // S41
-		Accept: 3,
+		Accept: 22,
 		Ignore: "",
 	},
 	ActionRow{ // S42
-		Accept: 33,
+		Accept: 32,
 		Ignore: "",
 	},
 	ActionRow{ // S43
-		Accept: 3,
+		Accept: 0,
 		Ignore: "",
 	},
 	ActionRow{ // S44
-		Accept: 3,
+		Accept: 41,
 		Ignore: "",
 	},
 	ActionRow{ // S45
-		Accept: 3,
+		Accept: 0,
 		Ignore: "",
 	},
 	ActionRow{ // S46
-		Accept: 3,
+		Accept: 0,
 		Ignore: "",
 	},
 	ActionRow{ // S47
-		Accept: 30,
+		Accept: 0,
 		Ignore: "",
 	},
 	ActionRow{ // S48
-		Accept: 3,
+		Accept: 0,
 		Ignore: "",
 	},
 	ActionRow{ // S49
-		Accept: 3,
+		Accept: 27,
 		Ignore: "",
 	},
 	ActionRow{ // S50
-		Accept: 3,
+		Accept: 0,
 		Ignore: "",
 	},
 	ActionRow{ // S51
-		Accept: 3,
+		Accept: 0,
 		Ignore: "",
 	},
 	ActionRow{ // S52
-		Accept: 3,
+		Accept: 34,
 		Ignore: "",
 	},
 	ActionRow{ // S53
-		Accept: 3,
+		Accept: 33,
 		Ignore: "",
 	},
 	ActionRow{ // S54
-		Accept: 3,
+		Accept: 35,
 		Ignore: "",
 	},
 	ActionRow{ // S55
-		Accept: -1,
-		Ignore: "!comments",
+		Accept: 3,
+		Ignore: "",
 	},
 	ActionRow{ // S56
-		Accept: 0,
+		Accept: 3,
 		Ignore: "",
 	},
 	ActionRow{ // S57
-		Accept: 0,
+		Accept: 3,
 		Ignore: "",
 	},
 	ActionRow{ // S58
-		Accept: 0,
+		Accept: 3,
 		Ignore: "",
 	},
 	ActionRow{ // S59
-		Accept: 0,
+		Accept: 3,
 		Ignore: "",
 	},
 	ActionRow{ // S60
-		Accept: 0,
+		Accept: 3,
 		Ignore: "",
 	},
 	ActionRow{ // S61
-		Accept: 29,
+		Accept: 3,
 		Ignore: "",
 	},
 	ActionRow{ // S62
@@ -273,11 +273,11 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S63
-		Accept: 3,
+		Accept: 52,
 		Ignore: "",
 	},
 	ActionRow{ // S64
-		Accept: 6,
+		Accept: 3,
 		Ignore: "",
 	},
 	ActionRow{ // S65
@@ -285,7 +285,7 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S66
-		Accept: 11,
+		Accept: 3,
 		Ignore: "",
 	},
 	ActionRow{ // S67
@@ -301,11 +301,11 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S70
-		Accept: 3,
+		Accept: 46,
 		Ignore: "",
 	},
 	ActionRow{ // S71
-		Accept: 7,
+		Accept: 3,
 		Ignore: "",
 	},
 	ActionRow{ // S72
@@ -317,11 +317,11 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S74
-		Accept: 31,
+		Accept: 3,
 		Ignore: "",
 	},
 	ActionRow{ // S75
-		Accept: 3,
+		Accept: 24,
 		Ignore: "",
 	},
 	ActionRow{ // S76
@@ -329,7 +329,7 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S77
-		Accept: 5,
+		Accept: 3,
 		Ignore: "",
 	},
 	ActionRow{ // S78
@@ -341,11 +341,11 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S80
-		Accept: 3,
+		Accept: 54,
 		Ignore: "",
 	},
 	ActionRow{ // S81
-		Accept: 17,
+		Accept: 3,
 		Ignore: "",
 	},
 	ActionRow{ // S82
@@ -353,39 +353,319 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S83
-		Accept: 8,
+		Accept: 3,
 		Ignore: "",
 	},
 	ActionRow{ // S84
-		Accept: 12,
+		Accept: 3,
 		Ignore: "",
 	},
 	ActionRow{ // S85
-		Accept: 34,
+		Accept: 25,
 		Ignore: "",
 	},
 	ActionRow{ // S86
-		Accept: 3,
-		Ignore: "",
+		Accept: -1,
+		Ignore: "!comments",
 	},
 	ActionRow{ // S87
-		Accept: 3,
+		Accept: 0,
 		Ignore: "",
 	},
 	ActionRow{ // S88
-		Accept: 32,
+		Accept: 0,
 		Ignore: "",
 	},
 	ActionRow{ // S89
-		Accept: 3,
+		Accept: 0,
 		Ignore: "",
 	},
 	ActionRow{ // S90
-		Accept: 36,
+		Accept: 0,
 		Ignore: "",
 	},
 	ActionRow{ // S91
+		Accept: 0,
+		Ignore: "",
+	},
+	ActionRow{ // S92
+		Accept: 45,
+		Ignore: "",
+	},
+	ActionRow{ // S93
+		Accept: 26,
+		Ignore: "",
+	},
+	ActionRow{ // S94
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S95
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S96
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S97
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S98
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S99
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S100
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S101
+		Accept: 6,
+		Ignore: "",
+	},
+	ActionRow{ // S102
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S103
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S104
+		Accept: 53,
+		Ignore: "",
+	},
+	ActionRow{ // S105
+		Accept: 14,
+		Ignore: "",
+	},
+	ActionRow{ // S106
+		Accept: 44,
+		Ignore: "",
+	},
+	ActionRow{ // S107
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S108
+		Accept: 28,
+		Ignore: "",
+	},
+	ActionRow{ // S109
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S110
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S111
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S112
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S113
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S114
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S115
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S116
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S117
+		Accept: 7,
+		Ignore: "",
+	},
+	ActionRow{ // S118
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S119
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S120
+		Accept: 16,
+		Ignore: "",
+	},
+	ActionRow{ // S121
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S122
+		Accept: 49,
+		Ignore: "",
+	},
+	ActionRow{ // S123
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S124
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S125
+		Accept: 47,
+		Ignore: "",
+	},
+	ActionRow{ // S126
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S127
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S128
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S129
+		Accept: 5,
+		Ignore: "",
+	},
+	ActionRow{ // S130
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S131
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S132
+		Accept: 59,
+		Ignore: "",
+	},
+	ActionRow{ // S133
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S134
+		Accept: 55,
+		Ignore: "",
+	},
+	ActionRow{ // S135
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S136
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S137
+		Accept: 42,
+		Ignore: "",
+	},
+	ActionRow{ // S138
+		Accept: 20,
+		Ignore: "",
+	},
+	ActionRow{ // S139
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S140
+		Accept: 56,
+		Ignore: "",
+	},
+	ActionRow{ // S141
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S142
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S143
+		Accept: 8,
+		Ignore: "",
+	},
+	ActionRow{ // S144
+		Accept: 43,
+		Ignore: "",
+	},
+	ActionRow{ // S145
+		Accept: 15,
+		Ignore: "",
+	},
+	ActionRow{ // S146
+		Accept: 58,
+		Ignore: "",
+	},
+	ActionRow{ // S147
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S148
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S149
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S150
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S151
+		Accept: 51,
+		Ignore: "",
+	},
+	ActionRow{ // S152
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S153
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S154
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S155
+		Accept: 60,
+		Ignore: "",
+	},
+	ActionRow{ // S156
+		Accept: 17,
+		Ignore: "",
+	},
+	ActionRow{ // S157
+		Accept: 48,
+		Ignore: "",
+	},
+	ActionRow{ // S158
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S159
+		Accept: 50,
+		Ignore: "",
+	},
+	ActionRow{ // S160
 		Accept: 2,
 		Ignore: "",
 	},
+	ActionRow{ // S161
+		Accept: 57,
+		Ignore: "",
+	},
 }
@@ -11,8 +11,8 @@ import (
 
 const (
 	NoState    = -1
-	NumStates  = 92
-	NumSymbols = 139
+	NumStates  = 162
+	NumSymbols = 224
 )
 
 type Lexer struct {
@@ -159,113 +159,198 @@ Lexer symbols:
 27: 'e'
 28: 'd'
 29: 'o'
-30: 'p'
-31: 'r'
-32: 'i'
-33: 'n'
-34: 't'
-35: 'i'
-36: 'n'
-37: 't'
-38: 'f'
-39: 'l'
-40: 'o'
-41: 'a'
-42: 't'
-43: 'v'
-44: 'o'
-45: 'i'
-46: 'd'
-47: 'r'
-48: 'e'
-49: 't'
+30: 'f'
+31: 'o'
+32: 'r'
+33: 't'
+34: 'o'
+35: 's'
+36: 't'
+37: 'e'
+38: 'p'
+39: 'b'
+40: 'r'
+41: 'e'
+42: 'a'
+43: 'k'
+44: 'c'
+45: 'o'
+46: 'n'
+47: 't'
+48: 'i'
+49: 'n'
 50: 'u'
-51: 'r'
-52: 'n'
-53: '.'
-54: '"'
-55: '"'
-56: '+'
-57: '-'
-58: '*'
-59: '/'
-60: '>'
-61: '<'
-62: '!'
-63: '='
-64: '='
-65: ';'
-66: ':'
-67: ','
-68: '('
-69: ')'
-70: '{'
-71: '}'
-72: '['
-73: ']'
-74: 'e'
-75: 'm'
-76: 'p'
-77: 't'
-78: 'y'
-79: ' '
-80: '!'
-81: '#'
-82: '$'
-83: '%'
-84: '&'
-85: '''
-86: '('
-87: ')'
-88: '*'
-89: '+'
-90: ','
-91: '-'
-92: '.'
-93: '/'
-94: ':'
-95: ';'
-96: '<'
-97: '='
-98: '>'
-99: '?'
-100: '@'
-101: '['
-102: ']'
-103: '^'
-104: '_'
-105: '`'
-106: '{'
-107: '|'
-108: '}'
-109: '~'
-110: \u00e1
-111: \u00e9
-112: \u00ed
-113: \u00f3
-114: \u00fa
-115: \u00f1
-116: \u00fc
-117: \u00f8
-118: \u00c1
-119: \u00c9
-120: \u00cd
-121: \u00d3
-122: \u00da
-123: \u00d1
-124: \u00dc
-125: \u00d8
-126: ' '
-127: '\t'
-128: '\n'
-129: '\r'
-130: '/'
-131: '/'
-132: '\t'
-133: '\n'
-134: '\r'
-135: 'a'-'z'
-136: 'A'-'Z'
-137: '0'-'9'
-138: .
+51: 'e'
+52: 's'
+53: 'w'
+54: 'i'
+55: 't'
+56: 'c'
+57: 'h'
+58: 'c'
+59: 'a'
+60: 's'
+61: 'e'
+62: 'd'
+63: 'e'
+64: 'f'
+65: 'a'
+66: 'u'
+67: 'l'
+68: 't'
+69: 'p'
+70: 'r'
+71: 'i'
+72: 'n'
+73: 't'
+74: 'r'
+75: 'e'
+76: 'a'
+77: 'd'
+78: 'i'
+79: 'n'
+80: 't'
+81: 'f'
+82: 'l'
+83: 'o'
+84: 'a'
+85: 't'
+86: 'b'
+87: 'o'
+88: 'o'
+89: 'l'
+90: 's'
+91: 't'
+92: 'r'
+93: 'i'
+94: 'n'
+95: 'g'
+96: 'l'
+97: 'e'
+98: 'n'
+99: 't'
+100: 'r'
+101: 'u'
+102: 'e'
+103: 'f'
+104: 'a'
+105: 'l'
+106: 's'
+107: 'e'
+108: 'v'
+109: 'o'
+110: 'i'
+111: 'd'
+112: 'r'
+113: 'e'
+114: 't'
+115: 'u'
+116: 'r'
+117: 'n'
+118: 'a'
+119: 'n'
+120: 'd'
+121: 'o'
+122: 'r'
+123: 'n'
+124: 'o'
+125: 't'
+126: '.'
+127: '"'
+128: '"'
+129: '+'
+130: '-'
+131: '*'
+132: '/'
+133: '%'
+134: '&'
+135: '&'
+136: '|'
+137: '|'
+138: '!'
+139: '>'
+140: '<'
+141: '!'
+142: '='
+143: '='
+144: '='
+145: '<'
+146: '='
+147: '>'
+148: '='
+149: '='
+150: ';'
+151: ':'
+152: ','
+153: '('
+154: ')'
+155: '{'
+156: '}'
+157: '['
+158: ']'
+159: 'e'
+160: 'm'
+161: 'p'
+162: 't'
+163: 'y'
+164: ' '
+165: '!'
+166: '#'
+167: '$'
+168: '%'
+169: '&'
+170: '''
+171: '('
+172: ')'
+173: '*'
+174: '+'
+175: ','
+176: '-'
+177: '.'
+178: '/'
+179: ':'
+180: ';'
+181: '<'
+182: '='
+183: '>'
+184: '?'
+185: '@'
+186: '['
+187: ']'
+188: '^'
+189: '_'
+190: '`'
+191: '{'
+192: '|'
+193: '}'
+194: '~'
+195: \u00e1
+196: \u00e9
+197: \u00ed
+198: \u00f3
+199: \u00fa
+200: \u00f1
+201: \u00fc
+202: \u00f8
+203: \u00c1
+204: \u00c9
+205: \u00cd
+206: \u00d3
+207: \u00da
+208: \u00d1
+209: \u00dc
+210: \u00d8
+211: ' '
+212: '\t'
+213: '\n'
+214: '\r'
+215: '/'
+216: '/'
+217: '\t'
+218: '\n'
+219: '\r'
+220: 'a'-'z'
+221: 'A'-'Z'
+222: '0'-'9'
+223: .
 */
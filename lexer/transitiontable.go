@@ -25,72 +25,90 @@ var TransTab = TransitionTable{
 			return 2
 		case r == 34: // ['"','"']
 			return 3
-		case r == 40: // ['(','(']
+		case r == 37: // ['%','%']
 			return 4
-		case r == 41: // [')',')']
+		case r == 38: // ['&','&']
 			return 5
-		case r == 42: // ['*','*']
+		case r == 40: // ['(','(']
 			return 6
-		case r == 43: // ['+','+']
+		case r == 41: // [')',')']
 			return 7
-		case r == 44: // [',',',']
+		case r == 42: // ['*','*']
 			return 8
-		case r == 45: // ['-','-']
+		case r == 43: // ['+','+']
 			return 9
-		case r == 47: // ['/','/']
+		case r == 44: // [',',',']
 			return 10
-		case 48 <= r && r <= 57: // ['0','9']
+		case r == 45: // ['-','-']
 			return 11
-		case r == 58: // [':',':']
+		case r == 47: // ['/','/']
 			return 12
-		case r == 59: // [';',';']
+		case 48 <= r && r <= 57: // ['0','9']
 			return 13
-		case r == 60: // ['<','<']
+		case r == 58: // [':',':']
 			return 14
-		case r == 61: // ['=','=']
+		case r == 59: // [';',';']
 			return 15
-		case r == 62: // ['>','>']
+		case r == 60: // ['<','<']
 			return 16
-		case r == 91: // ['[','[']
+		case r == 61: // ['=','=']
 			return 17
-		case r == 93: // [']',']']
+		case r == 62: // ['>','>']
 			return 18
-		case 97 <= r && r <= 99: // ['a','c']
+		case r == 91: // ['[','[']
 			return 19
-		case r == 100: // ['d','d']
+		case r == 93: // [']',']']
 			return 20
-		case r == 101: // ['e','e']
+		case r == 97: // ['a','a']
 			return 21
-		case r == 102: // ['f','f']
+		case r == 98: // ['b','b']
 			return 22
+		case r == 99: // ['c','c']
+			return 23
+		case r == 100: // ['d','d']
+			return 24
+		case r == 101: // ['e','e']
+			return 25
+		case r == 102: // ['f','f']
+			return 26
 		case 103 <= r && r <= 104: // ['g','h']
-			return 19
+			return 27
 		case r == 105: // ['i','i']
-			return 23
-		case 106 <= r && r <= 108: // ['j','l']
-			return 19
+			return 28
+		case 106 <= r && r <= 107: // ['j','k']
+			return 27
+		case r == 108: // ['l','l']
+			return 29
 		case r == 109: // ['m','m']
-			return 24
-		case 110 <= r && r <= 111: // ['n','o']
-			return 19
+			return 30
+		case r == 110: // ['n','n']
+			return 31
+		case r == 111: // ['o','o']
+			return 32
 		case r == 112: // ['p','p']
-			return 25
+			return 33
 		case r == 113: // ['q','q']
-			return 19
+			return 27
 		case r == 114: // ['r','r']
-			return 26
-		case 115 <= r && r <= 117: // ['s','u']
-			return 19
-		case r == 118: // ['v','v']
+			return 34
+		case r == 115: // ['s','s']
+			return 35
+		case r == 116: // ['t','t']
+			return 36
+		case r == 117: // ['u','u']
 			return 27
+		case r == 118: // ['v','v']
+			return 37
 		case r == 119: // ['w','w']
-			return 28
+			return 38
 		case 120 <= r && r <= 122: // ['x','z']
-			return 19
+			return 27
 		case r == 123: // ['{','{']
-			return 29
+			return 39
+		case r == 124: // ['|','|']
+			return 40
 		case r == 125: // ['}','}']
-			return 30
+			return 41
 		}
 		return NoState
 	},
@@ -104,7 +122,7 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case r == 61: // ['=','=']
-			return 31
+			return 42
 		}
 		return NoState
 	},
@@ -112,105 +130,105 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case r == 32: // [' ',' ']
-			return 32
+			return 43
 		case r == 33: // ['!','!']
-			return 32
+			return 43
 		case r == 34: // ['"','"']
-			return 33
+			return 44
 		case r == 35: // ['#','#']
-			return 32
+			return 43
 		case r == 36: // ['$','$']
-			return 32
+			return 43
 		case r == 37: // ['%','%']
-			return 32
+			return 43
 		case r == 38: // ['&','&']
-			return 32
+			return 43
 		case r == 39: // [''',''']
-			return 34
+			return 45
 		case r == 41: // [')',')']
-			return 32
+			return 43
 		case r == 42: // ['*','*']
-			return 32
+			return 43
 		case r == 43: // ['+','+']
-			return 32
+			return 43
 		case r == 44: // [',',',']
-			return 32
+			return 43
 		case r == 45: // ['-','-']
-			return 32
+			return 43
 		case r == 46: // ['.','.']
-			return 32
+			return 43
 		case r == 47: // ['/','/']
-			return 32
+			return 43
 		case 48 <= r && r <= 57: // ['0','9']
-			return 35
+			return 46
 		case r == 58: // [':',':']
-			return 32
+			return 43
 		case r == 59: // [';',';']
-			return 32
+			return 43
 		case r == 60: // ['<','<']
-			return 32
+			return 43
 		case r == 61: // ['=','=']
-			return 32
+			return 43
 		case r == 62: // ['>','>']
-			return 32
+			return 43
 		case r == 63: // ['?','?']
-			return 32
+			return 43
 		case r == 64: // ['@','@']
-			return 32
+			return 43
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 36
+			return 47
 		case r == 91: // ['[','[']
-			return 32
+			return 43
 		case r == 93: // [']',']']
-			return 32
+			return 43
 		case r == 94: // ['^','^']
-			return 32
+			return 43
 		case r == 95: // ['_','_']
-			return 32
+			return 43
 		case r == 96: // ['`','`']
-			return 32
+			return 43
 		case 97 <= r && r <= 122: // ['a','z']
-			return 37
+			return 48
 		case r == 123: // ['{','{']
-			return 32
+			return 43
 		case r == 124: // ['|','|']
-			return 32
+			return 43
 		case r == 125: // ['}','}']
-			return 32
+			return 43
 		case r == 126: // ['~','~']
-			return 32
+			return 43
 		case r == 193: // [\u00c1,\u00c1]
-			return 32
+			return 43
 		case r == 201: // [\u00c9,\u00c9]
-			return 32
+			return 43
 		case r == 205: // [\u00cd,\u00cd]
-			return 32
+			return 43
 		case r == 209: // [\u00d1,\u00d1]
-			return 32
+			return 43
 		case r == 211: // [\u00d3,\u00d3]
-			return 32
+			return 43
 		case r == 216: // [\u00d8,\u00d8]
-			return 32
+			return 43
 		case r == 218: // [\u00da,\u00da]
-			return 32
+			return 43
 		case r == 220: // [\u00dc,\u00dc]
-			return 32
+			return 43
 		case r == 225: // [\u00e1,\u00e1]
-			return 32
+			return 43
 		case r == 233: // [\u00e9,\u00e9]
-			return 32
+			return 43
 		case r == 237: // [\u00ed,\u00ed]
-			return 32
+			return 43
 		case r == 241: // [\u00f1,\u00f1]
-			return 32
+			return 43
 		case r == 243: // [\u00f3,\u00f3]
-			return 32
+			return 43
 		case r == 248: // [\u00f8,\u00f8]
-			return 32
+			return 43
 		case r == 250: // [\u00fa,\u00fa]
-			return 32
+			return 43
 		case r == 252: // [\u00fc,\u00fc]
-			return 32
+			return 43
 		}
 		return NoState
 	},
@@ -223,6 +241,8 @@ var TransTab = TransitionTable{
 	// S5
 	func(r rune) int {
 		switch {
+		case r == 38: // ['&','&']
+			return 49
 		}
 		return NoState
 	},
@@ -253,30 +273,30 @@ var TransTab = TransitionTable{
 	// S10
 	func(r rune) int {
 		switch {
-		case r == 47: // ['/','/']
-			return 38
 		}
 		return NoState
 	},
 	// S11
 	func(r rune) int {
 		switch {
-		case r == 46: // ['.','.']
-			return 39
-		case 48 <= r && r <= 57: // ['0','9']
-			return 11
 		}
 		return NoState
 	},
 	// S12
 	func(r rune) int {
 		switch {
+		case r == 47: // ['/','/']
+			return 50
 		}
 		return NoState
 	},
 	// S13
 	func(r rune) int {
 		switch {
+		case r == 46: // ['.','.']
+			return 51
+		case 48 <= r && r <= 57: // ['0','9']
+			return 13
 		}
 		return NoState
 	},
@@ -295,46 +315,36 @@ var TransTab = TransitionTable{
 	// S16
 	func(r rune) int {
 		switch {
+		case r == 61: // ['=','=']
+			return 52
 		}
 		return NoState
 	},
 	// S17
 	func(r rune) int {
 		switch {
+		case r == 61: // ['=','=']
+			return 53
 		}
 		return NoState
 	},
 	// S18
 	func(r rune) int {
 		switch {
+		case r == 61: // ['=','=']
+			return 54
 		}
 		return NoState
 	},
 	// S19
 	func(r rune) int {
 		switch {
-		case 48 <= r && r <= 57: // ['0','9']
-			return 40
-		case 65 <= r && r <= 90: // ['A','Z']
-			return 41
-		case 97 <= r && r <= 122: // ['a','z']
-			return 19
 		}
 		return NoState
 	},
 	// S20
 	func(r rune) int {
 		switch {
-		case 48 <= r && r <= 57: // ['0','9']
-			return 40
-		case 65 <= r && r <= 90: // ['A','Z']
-			return 41
-		case 97 <= r && r <= 110: // ['a','n']
-			return 19
-		case r == 111: // ['o','o']
-			return 42
-		case 112 <= r && r <= 122: // ['p','z']
-			return 19
 		}
 		return NoState
 	},
@@ -342,19 +352,15 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 55
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 41
-		case 97 <= r && r <= 107: // ['a','k']
-			return 19
-		case r == 108: // ['l','l']
-			return 43
-		case r == 109: // ['m','m']
-			return 44
+			return 56
+		case 97 <= r && r <= 109: // ['a','m']
+			return 27
 		case r == 110: // ['n','n']
-			return 45
+			return 57
 		case 111 <= r && r <= 122: // ['o','z']
-			return 19
+			return 27
 		}
 		return NoState
 	},
@@ -362,99 +368,111 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
-		case 65 <= r && r <= 90: // ['A','Z']
-			return 41
-		case 97 <= r && r <= 107: // ['a','k']
-			return 19
-		case r == 108: // ['l','l']
-			return 46
-		case 109 <= r && r <= 122: // ['m','z']
-			return 19
-		}
-		return NoState
-	},
-	// S23
-	func(r rune) int {
-		switch {
-		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 55
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 41
-		case 97 <= r && r <= 101: // ['a','e']
-			return 19
-		case r == 102: // ['f','f']
-			return 47
-		case 103 <= r && r <= 109: // ['g','m']
-			return 19
-		case r == 110: // ['n','n']
-			return 48
-		case 111 <= r && r <= 122: // ['o','z']
-			return 19
+			return 56
+		case 97 <= r && r <= 110: // ['a','n']
+			return 27
+		case r == 111: // ['o','o']
+			return 58
+		case 112 <= r && r <= 113: // ['p','q']
+			return 27
+		case r == 114: // ['r','r']
+			return 59
+		case 115 <= r && r <= 122: // ['s','z']
+			return 27
 		}
 		return NoState
 	},
-	// S24
+	// S23
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 55
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 41
+			return 56
 		case r == 97: // ['a','a']
-			return 49
-		case 98 <= r && r <= 122: // ['b','z']
-			return 19
+			return 60
+		case 98 <= r && r <= 110: // ['b','n']
+			return 27
+		case r == 111: // ['o','o']
+			return 61
+		case 112 <= r && r <= 122: // ['p','z']
+			return 27
 		}
 		return NoState
 	},
-	// S25
+	// S24
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 55
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 41
-		case 97 <= r && r <= 113: // ['a','q']
-			return 19
-		case r == 114: // ['r','r']
-			return 50
-		case 115 <= r && r <= 122: // ['s','z']
-			return 19
+			return 56
+		case 97 <= r && r <= 100: // ['a','d']
+			return 27
+		case r == 101: // ['e','e']
+			return 62
+		case 102 <= r && r <= 110: // ['f','n']
+			return 27
+		case r == 111: // ['o','o']
+			return 63
+		case 112 <= r && r <= 122: // ['p','z']
+			return 27
 		}
 		return NoState
 	},
-	// S26
+	// S25
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 55
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 41
-		case 97 <= r && r <= 100: // ['a','d']
-			return 19
-		case r == 101: // ['e','e']
-			return 51
-		case 102 <= r && r <= 122: // ['f','z']
-			return 19
+			return 56
+		case 97 <= r && r <= 107: // ['a','k']
+			return 27
+		case r == 108: // ['l','l']
+			return 64
+		case r == 109: // ['m','m']
+			return 65
+		case r == 110: // ['n','n']
+			return 66
+		case 111 <= r && r <= 122: // ['o','z']
+			return 27
 		}
 		return NoState
 	},
-	// S27
+	// S26
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 55
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 41
+			return 56
 		case r == 97: // ['a','a']
-			return 52
-		case 98 <= r && r <= 110: // ['b','n']
-			return 19
+			return 67
+		case 98 <= r && r <= 107: // ['b','k']
+			return 27
+		case r == 108: // ['l','l']
+			return 68
+		case 109 <= r && r <= 110: // ['m','n']
+			return 27
 		case r == 111: // ['o','o']
-			return 53
+			return 69
 		case 112 <= r && r <= 122: // ['p','z']
-			return 19
+			return 27
+		}
+		return NoState
+	},
+	// S27
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 55
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 56
+		case 97 <= r && r <= 122: // ['a','z']
+			return 27
 		}
 		return NoState
 	},
@@ -462,1707 +480,2715 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 55
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 41
-		case 97 <= r && r <= 103: // ['a','g']
-			return 19
-		case r == 104: // ['h','h']
-			return 54
-		case 105 <= r && r <= 122: // ['i','z']
-			return 19
+			return 56
+		case 97 <= r && r <= 101: // ['a','e']
+			return 27
+		case r == 102: // ['f','f']
+			return 70
+		case 103 <= r && r <= 109: // ['g','m']
+			return 27
+		case r == 110: // ['n','n']
+			return 71
+		case 111 <= r && r <= 122: // ['o','z']
+			return 27
 		}
 		return NoState
 	},
 	// S29
 	func(r rune) int {
 		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 55
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 56
+		case 97 <= r && r <= 100: // ['a','d']
+			return 27
+		case r == 101: // ['e','e']
+			return 72
+		case 102 <= r && r <= 122: // ['f','z']
+			return 27
 		}
 		return NoState
 	},
 	// S30
 	func(r rune) int {
 		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 55
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 56
+		case r == 97: // ['a','a']
+			return 73
+		case 98 <= r && r <= 122: // ['b','z']
+			return 27
 		}
 		return NoState
 	},
 	// S31
 	func(r rune) int {
 		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 55
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 56
+		case 97 <= r && r <= 110: // ['a','n']
+			return 27
+		case r == 111: // ['o','o']
+			return 74
+		case 112 <= r && r <= 122: // ['p','z']
+			return 27
 		}
 		return NoState
 	},
 	// S32
 	func(r rune) int {
 		switch {
-		case r == 32: // [' ',' ']
-			return 32
-		case r == 33: // ['!','!']
-			return 32
-		case r == 34: // ['"','"']
-			return 33
-		case r == 35: // ['#','#']
-			return 32
-		case r == 36: // ['$','$']
-			return 32
-		case r == 37: // ['%','%']
-			return 32
-		case r == 38: // ['&','&']
-			return 32
-		case r == 39: // [''',''']
-			return 34
-		case r == 41: // [')',')']
-			return 32
-		case r == 42: // ['*','*']
-			return 32
-		case r == 43: // ['+','+']
-			return 32
-		case r == 44: // [',',',']
-			return 32
-		case r == 45: // ['-','-']
-			return 32
-		case r == 46: // ['.','.']
-			return 32
-		case r == 47: // ['/','/']
-			return 32
 		case 48 <= r && r <= 57: // ['0','9']
-			return 35
-		case r == 58: // [':',':']
-			return 32
-		case r == 59: // [';',';']
-			return 32
-		case r == 60: // ['<','<']
-			return 32
-		case r == 61: // ['=','=']
-			return 32
-		case r == 62: // ['>','>']
-			return 32
-		case r == 63: // ['?','?']
-			return 32
-		case r == 64: // ['@','@']
-			return 32
+			return 55
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 36
-		case r == 91: // ['[','[']
-			return 32
-		case r == 93: // [']',']']
-			return 32
-		case r == 94: // ['^','^']
-			return 32
-		case r == 95: // ['_','_']
-			return 32
-		case r == 96: // ['`','`']
-			return 32
-		case 97 <= r && r <= 122: // ['a','z']
-			return 37
-		case r == 123: // ['{','{']
-			return 32
-		case r == 124: // ['|','|']
-			return 32
-		case r == 125: // ['}','}']
-			return 32
-		case r == 126: // ['~','~']
-			return 32
-		case r == 193: // [\u00c1,\u00c1]
-			return 32
-		case r == 201: // [\u00c9,\u00c9]
-			return 32
-		case r == 205: // [\u00cd,\u00cd]
-			return 32
-		case r == 209: // [\u00d1,\u00d1]
-			return 32
-		case r == 211: // [\u00d3,\u00d3]
-			return 32
-		case r == 216: // [\u00d8,\u00d8]
-			return 32
-		case r == 218: // [\u00da,\u00da]
-			return 32
-		case r == 220: // [\u00dc,\u00dc]
-			return 32
-		case r == 225: // [\u00e1,\u00e1]
-			return 32
-		case r == 233: // [\u00e9,\u00e9]
-			return 32
-		case r == 237: // [\u00ed,\u00ed]
-			return 32
-		case r == 241: // [\u00f1,\u00f1]
-			return 32
-		case r == 243: // [\u00f3,\u00f3]
-			return 32
-		case r == 248: // [\u00f8,\u00f8]
-			return 32
-		case r == 250: // [\u00fa,\u00fa]
-			return 32
-		case r == 252: // [\u00fc,\u00fc]
-			return 32
+			return 56
+		case 97 <= r && r <= 113: // ['a','q']
+			return 27
+		case r == 114: // ['r','r']
+			return 75
+		case 115 <= r && r <= 122: // ['s','z']
+			return 27
 		}
 		return NoState
 	},
 	// S33
 	func(r rune) int {
 		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 55
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 56
+		case 97 <= r && r <= 113: // ['a','q']
+			return 27
+		case r == 114: // ['r','r']
+			return 76
+		case 115 <= r && r <= 122: // ['s','z']
+			return 27
 		}
 		return NoState
 	},
 	// S34
 	func(r rune) int {
 		switch {
-		case r == 40: // ['(','(']
-			return 32
+		case 48 <= r && r <= 57: // ['0','9']
+			return 55
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 56
+		case 97 <= r && r <= 100: // ['a','d']
+			return 27
+		case r == 101: // ['e','e']
+			return 77
+		case 102 <= r && r <= 122: // ['f','z']
+			return 27
 		}
 		return NoState
 	},
 	// S35
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 55
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 56
+		case 97 <= r && r <= 115: // ['a','s']
+			return 27
+		case r == 116: // ['t','t']
+			return 78
+		case 117 <= r && r <= 118: // ['u','v']
+			return 27
+		case r == 119: // ['w','w']
+			return 79
+		case 120 <= r && r <= 122: // ['x','z']
+			return 27
+		}
+		return NoState
+	},
+	// S36
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 55
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 56
+		case 97 <= r && r <= 110: // ['a','n']
+			return 27
+		case r == 111: // ['o','o']
+			return 80
+		case 112 <= r && r <= 113: // ['p','q']
+			return 27
+		case r == 114: // ['r','r']
+			return 81
+		case 115 <= r && r <= 122: // ['s','z']
+			return 27
+		}
+		return NoState
+	},
+	// S37
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 55
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 56
+		case r == 97: // ['a','a']
+			return 82
+		case 98 <= r && r <= 110: // ['b','n']
+			return 27
+		case r == 111: // ['o','o']
+			return 83
+		case 112 <= r && r <= 122: // ['p','z']
+			return 27
+		}
+		return NoState
+	},
+	// S38
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 55
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 56
+		case 97 <= r && r <= 103: // ['a','g']
+			return 27
+		case r == 104: // ['h','h']
+			return 84
+		case 105 <= r && r <= 122: // ['i','z']
+			return 27
+		}
+		return NoState
+	},
+	// S39
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S40
+	func(r rune) int {
+		switch {
+		case r == 124: // ['|','|']
+			return 85
+		}
+		return NoState
+	},
+	// S41
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S42
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S43
 	func(r rune) int {
 		switch {
 		case r == 32: // [' ',' ']
-			return 32
+			return 43
 		case r == 33: // ['!','!']
-			return 32
+			return 43
 		case r == 34: // ['"','"']
-			return 33
+			return 44
 		case r == 35: // ['#','#']
-			return 32
+			return 43
 		case r == 36: // ['$','$']
-			return 32
+			return 43
 		case r == 37: // ['%','%']
-			return 32
+			return 43
 		case r == 38: // ['&','&']
-			return 32
+			return 43
 		case r == 39: // [''',''']
-			return 34
+			return 45
 		case r == 41: // [')',')']
-			return 32
+			return 43
 		case r == 42: // ['*','*']
-			return 32
+			return 43
 		case r == 43: // ['+','+']
-			return 32
+			return 43
 		case r == 44: // [',',',']
-			return 32
+			return 43
 		case r == 45: // ['-','-']
-			return 32
+			return 43
 		case r == 46: // ['.','.']
-			return 32
+			return 43
 		case r == 47: // ['/','/']
-			return 32
+			return 43
 		case 48 <= r && r <= 57: // ['0','9']
-			return 35
+			return 46
 		case r == 58: // [':',':']
-			return 32
+			return 43
 		case r == 59: // [';',';']
-			return 32
+			return 43
 		case r == 60: // ['<','<']
-			return 32
+			return 43
 		case r == 61: // ['=','=']
-			return 32
+			return 43
 		case r == 62: // ['>','>']
-			return 32
+			return 43
 		case r == 63: // ['?','?']
-			return 32
+			return 43
 		case r == 64: // ['@','@']
-			return 32
+			return 43
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 36
+			return 47
 		case r == 91: // ['[','[']
-			return 32
+			return 43
 		case r == 93: // [']',']']
-			return 32
+			return 43
 		case r == 94: // ['^','^']
-			return 32
+			return 43
 		case r == 95: // ['_','_']
-			return 32
+			return 43
 		case r == 96: // ['`','`']
-			return 32
+			return 43
 		case 97 <= r && r <= 122: // ['a','z']
-			return 37
+			return 48
 		case r == 123: // ['{','{']
-			return 32
+			return 43
 		case r == 124: // ['|','|']
-			return 32
+			return 43
 		case r == 125: // ['}','}']
-			return 32
+			return 43
 		case r == 126: // ['~','~']
-			return 32
+			return 43
 		case r == 193: // [\u00c1,\u00c1]
-			return 32
+			return 43
 		case r == 201: // [\u00c9,\u00c9]
-			return 32
+			return 43
 		case r == 205: // [\u00cd,\u00cd]
-			return 32
+			return 43
 		case r == 209: // [\u00d1,\u00d1]
-			return 32
+			return 43
 		case r == 211: // [\u00d3,\u00d3]
-			return 32
+			return 43
 		case r == 216: // [\u00d8,\u00d8]
-			return 32
+			return 43
 		case r == 218: // [\u00da,\u00da]
-			return 32
+			return 43
 		case r == 220: // [\u00dc,\u00dc]
-			return 32
+			return 43
 		case r == 225: // [\u00e1,\u00e1]
-			return 32
+			return 43
 		case r == 233: // [\u00e9,\u00e9]
-			return 32
+			return 43
 		case r == 237: // [\u00ed,\u00ed]
-			return 32
+			return 43
 		case r == 241: // [\u00f1,\u00f1]
-			return 32
+			return 43
 		case r == 243: // [\u00f3,\u00f3]
-			return 32
+			return 43
 		case r == 248: // [\u00f8,\u00f8]
-			return 32
+			return 43
 		case r == 250: // [\u00fa,\u00fa]
-			return 32
+			return 43
 		case r == 252: // [\u00fc,\u00fc]
-			return 32
+			return 43
 		}
 		return NoState
 	},
-	// S36
+	// S44
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S45
+	func(r rune) int {
+		switch {
+		case r == 40: // ['(','(']
+			return 43
+		}
+		return NoState
+	},
+	// S46
 	func(r rune) int {
 		switch {
 		case r == 32: // [' ',' ']
-			return 32
+			return 43
 		case r == 33: // ['!','!']
-			return 32
+			return 43
 		case r == 34: // ['"','"']
-			return 33
+			return 44
 		case r == 35: // ['#','#']
-			return 32
+			return 43
 		case r == 36: // ['$','$']
-			return 32
+			return 43
 		case r == 37: // ['%','%']
-			return 32
+			return 43
 		case r == 38: // ['&','&']
-			return 32
+			return 43
 		case r == 39: // [''',''']
-			return 34
+			return 45
 		case r == 41: // [')',')']
-			return 32
+			return 43
 		case r == 42: // ['*','*']
-			return 32
+			return 43
 		case r == 43: // ['+','+']
-			return 32
+			return 43
 		case r == 44: // [',',',']
-			return 32
+			return 43
 		case r == 45: // ['-','-']
-			return 32
+			return 43
 		case r == 46: // ['.','.']
-			return 32
+			return 43
 		case r == 47: // ['/','/']
-			return 32
+			return 43
 		case 48 <= r && r <= 57: // ['0','9']
-			return 35
+			return 46
 		case r == 58: // [':',':']
-			return 32
+			return 43
 		case r == 59: // [';',';']
-			return 32
+			return 43
 		case r == 60: // ['<','<']
-			return 32
+			return 43
 		case r == 61: // ['=','=']
-			return 32
+			return 43
 		case r == 62: // ['>','>']
-			return 32
+			return 43
 		case r == 63: // ['?','?']
-			return 32
+			return 43
 		case r == 64: // ['@','@']
-			return 32
+			return 43
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 36
+			return 47
 		case r == 91: // ['[','[']
-			return 32
+			return 43
 		case r == 93: // [']',']']
-			return 32
+			return 43
 		case r == 94: // ['^','^']
-			return 32
+			return 43
 		case r == 95: // ['_','_']
-			return 32
+			return 43
 		case r == 96: // ['`','`']
-			return 32
+			return 43
 		case 97 <= r && r <= 122: // ['a','z']
-			return 37
+			return 48
 		case r == 123: // ['{','{']
-			return 32
+			return 43
 		case r == 124: // ['|','|']
-			return 32
+			return 43
 		case r == 125: // ['}','}']
-			return 32
+			return 43
 		case r == 126: // ['~','~']
-			return 32
+			return 43
 		case r == 193: // [\u00c1,\u00c1]
-			return 32
+			return 43
 		case r == 201: // [\u00c9,\u00c9]
-			return 32
+			return 43
 		case r == 205: // [\u00cd,\u00cd]
-			return 32
+			return 43
 		case r == 209: // [\u00d1,\u00d1]
-			return 32
+			return 43
 		case r == 211: // [\u00d3,\u00d3]
-			return 32
+			return 43
 		case r == 216: // [\u00d8,\u00d8]
-			return 32
+			return 43
 		case r == 218: // [\u00da,\u00da]
-			return 32
+			return 43
 		case r == 220: // [\u00dc,\u00dc]
-			return 32
+			return 43
 		case r == 225: // [\u00e1,\u00e1]
-			return 32
+			return 43
 		case r == 233: // [\u00e9,\u00e9]
-			return 32
+			return 43
 		case r == 237: // [\u00ed,\u00ed]
-			return 32
+			return 43
 		case r == 241: // [\u00f1,\u00f1]
-			return 32
+			return 43
 		case r == 243: // [\u00f3,\u00f3]
-			return 32
+			return 43
 		case r == 248: // [\u00f8,\u00f8]
-			return 32
+			return 43
 		case r == 250: // [\u00fa,\u00fa]
-			return 32
+			return 43
 		case r == 252: // [\u00fc,\u00fc]
-			return 32
+			return 43
 		}
 		return NoState
 	},
-	// S37
+	// S47
 	func(r rune) int {
 		switch {
 		case r == 32: // [' ',' ']
-			return 32
+			return 43
 		case r == 33: // ['!','!']
-			return 32
+			return 43
 		case r == 34: // ['"','"']
-			return 33
+			return 44
 		case r == 35: // ['#','#']
-			return 32
+			return 43
 		case r == 36: // ['$','$']
-			return 32
+			return 43
 		case r == 37: // ['%','%']
-			return 32
+			return 43
 		case r == 38: // ['&','&']
-			return 32
+			return 43
 		case r == 39: // [''',''']
-			return 34
+			return 45
 		case r == 41: // [')',')']
-			return 32
+			return 43
 		case r == 42: // ['*','*']
-			return 32
+			return 43
 		case r == 43: // ['+','+']
-			return 32
+			return 43
 		case r == 44: // [',',',']
-			return 32
+			return 43
 		case r == 45: // ['-','-']
-			return 32
+			return 43
 		case r == 46: // ['.','.']
-			return 32
+			return 43
 		case r == 47: // ['/','/']
-			return 32
+			return 43
 		case 48 <= r && r <= 57: // ['0','9']
-			return 35
+			return 46
 		case r == 58: // [':',':']
-			return 32
+			return 43
 		case r == 59: // [';',';']
-			return 32
+			return 43
 		case r == 60: // ['<','<']
-			return 32
+			return 43
 		case r == 61: // ['=','=']
-			return 32
+			return 43
 		case r == 62: // ['>','>']
-			return 32
+			return 43
 		case r == 63: // ['?','?']
-			return 32
+			return 43
 		case r == 64: // ['@','@']
-			return 32
+			return 43
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 36
+			return 47
 		case r == 91: // ['[','[']
-			return 32
+			return 43
 		case r == 93: // [']',']']
-			return 32
+			return 43
 		case r == 94: // ['^','^']
-			return 32
+			return 43
 		case r == 95: // ['_','_']
-			return 32
+			return 43
 		case r == 96: // ['`','`']
-			return 32
+			return 43
 		case 97 <= r && r <= 122: // ['a','z']
-			return 37
+			return 48
 		case r == 123: // ['{','{']
-			return 32
+			return 43
 		case r == 124: // ['|','|']
-			return 32
+			return 43
 		case r == 125: // ['}','}']
-			return 32
+			return 43
 		case r == 126: // ['~','~']
-			return 32
+			return 43
 		case r == 193: // [\u00c1,\u00c1]
-			return 32
+			return 43
 		case r == 201: // [\u00c9,\u00c9]
-			return 32
+			return 43
 		case r == 205: // [\u00cd,\u00cd]
-			return 32
+			return 43
 		case r == 209: // [\u00d1,\u00d1]
-			return 32
+			return 43
 		case r == 211: // [\u00d3,\u00d3]
-			return 32
+			return 43
 		case r == 216: // [\u00d8,\u00d8]
-			return 32
+			return 43
 		case r == 218: // [\u00da,\u00da]
-			return 32
+			return 43
 		case r == 220: // [\u00dc,\u00dc]
-			return 32
+			return 43
 		case r == 225: // [\u00e1,\u00e1]
-			return 32
+			return 43
 		case r == 233: // [\u00e9,\u00e9]
-			return 32
+			return 43
 		case r == 237: // [\u00ed,\u00ed]
-			return 32
+			return 43
 		case r == 241: // [\u00f1,\u00f1]
-			return 32
+			return 43
 		case r == 243: // [\u00f3,\u00f3]
-			return 32
+			return 43
 		case r == 248: // [\u00f8,\u00f8]
-			return 32
+			return 43
 		case r == 250: // [\u00fa,\u00fa]
-			return 32
+			return 43
 		case r == 252: // [\u00fc,\u00fc]
-			return 32
+			return 43
 		}
 		return NoState
 	},
-	// S38
+	// S48
 	func(r rune) int {
 		switch {
-		case r == 9: // ['\t','\t']
-			return 55
-		case r == 10: // ['\n','\n']
-			return 55
-		case r == 13: // ['\r','\r']
-			return 55
 		case r == 32: // [' ',' ']
-			return 56
+			return 43
 		case r == 33: // ['!','!']
-			return 56
+			return 43
+		case r == 34: // ['"','"']
+			return 44
 		case r == 35: // ['#','#']
-			return 56
+			return 43
 		case r == 36: // ['$','$']
-			return 56
+			return 43
 		case r == 37: // ['%','%']
-			return 56
+			return 43
 		case r == 38: // ['&','&']
-			return 56
+			return 43
 		case r == 39: // [''',''']
-			return 57
+			return 45
 		case r == 41: // [')',')']
-			return 56
+			return 43
 		case r == 42: // ['*','*']
-			return 56
+			return 43
 		case r == 43: // ['+','+']
-			return 56
+			return 43
 		case r == 44: // [',',',']
-			return 56
+			return 43
 		case r == 45: // ['-','-']
-			return 56
+			return 43
 		case r == 46: // ['.','.']
-			return 56
+			return 43
 		case r == 47: // ['/','/']
-			return 56
+			return 43
 		case 48 <= r && r <= 57: // ['0','9']
-			return 58
+			return 46
 		case r == 58: // [':',':']
-			return 56
+			return 43
 		case r == 59: // [';',';']
-			return 56
+			return 43
 		case r == 60: // ['<','<']
-			return 56
+			return 43
 		case r == 61: // ['=','=']
-			return 56
+			return 43
 		case r == 62: // ['>','>']
-			return 56
+			return 43
 		case r == 63: // ['?','?']
-			return 56
+			return 43
 		case r == 64: // ['@','@']
-			return 56
+			return 43
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 59
+			return 47
 		case r == 91: // ['[','[']
-			return 56
+			return 43
 		case r == 93: // [']',']']
-			return 56
+			return 43
 		case r == 94: // ['^','^']
-			return 56
+			return 43
 		case r == 95: // ['_','_']
-			return 56
+			return 43
 		case r == 96: // ['`','`']
-			return 56
+			return 43
 		case 97 <= r && r <= 122: // ['a','z']
-			return 60
+			return 48
 		case r == 123: // ['{','{']
-			return 56
+			return 43
 		case r == 124: // ['|','|']
-			return 56
+			return 43
 		case r == 125: // ['}','}']
-			return 56
+			return 43
 		case r == 126: // ['~','~']
-			return 56
+			return 43
 		case r == 193: // [\u00c1,\u00c1]
-			return 56
+			return 43
 		case r == 201: // [\u00c9,\u00c9]
-			return 56
+			return 43
 		case r == 205: // [\u00cd,\u00cd]
-			return 56
+			return 43
 		case r == 209: // [\u00d1,\u00d1]
-			return 56
+			return 43
 		case r == 211: // [\u00d3,\u00d3]
-			return 56
+			return 43
 		case r == 216: // [\u00d8,\u00d8]
-			return 56
+			return 43
 		case r == 218: // [\u00da,\u00da]
-			return 56
+			return 43
 		case r == 220: // [\u00dc,\u00dc]
-			return 56
+			return 43
 		case r == 225: // [\u00e1,\u00e1]
-			return 56
+			return 43
 		case r == 233: // [\u00e9,\u00e9]
-			return 56
+			return 43
 		case r == 237: // [\u00ed,\u00ed]
-			return 56
+			return 43
 		case r == 241: // [\u00f1,\u00f1]
-			return 56
+			return 43
 		case r == 243: // [\u00f3,\u00f3]
-			return 56
+			return 43
 		case r == 248: // [\u00f8,\u00f8]
-			return 56
+			return 43
 		case r == 250: // [\u00fa,\u00fa]
-			return 56
+			return 43
 		case r == 252: // [\u00fc,\u00fc]
-			return 56
+			return 43
 		}
 		return NoState
 	},
-	// S39
+	// S49
 	func(r rune) int {
 		switch {
-		case 48 <= r && r <= 57: // ['0','9']
-			return 61
 		}
 		return NoState
 	},
-	// S40
+	// S50
 	func(r rune) int {
 		switch {
+		case r == 9: // ['\t','\t']
+			return 86
+		case r == 10: // ['\n','\n']
+			return 86
+		case r == 13: // ['\r','\r']
+			return 86
+		case r == 32: // [' ',' ']
+			return 87
+		case r == 33: // ['!','!']
+			return 87
+		case r == 35: // ['#','#']
+			return 87
+		case r == 36: // ['$','$']
+			return 87
+		case r == 37: // ['%','%']
+			return 87
+		case r == 38: // ['&','&']
+			return 87
+		case r == 39: // [''',''']
+			return 88
+		case r == 41: // [')',')']
+			return 87
+		case r == 42: // ['*','*']
+			return 87
+		case r == 43: // ['+','+']
+			return 87
+		case r == 44: // [',',',']
+			return 87
+		case r == 45: // ['-','-']
+			return 87
+		case r == 46: // ['.','.']
+			return 87
+		case r == 47: // ['/','/']
+			return 87
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 89
+		case r == 58: // [':',':']
+			return 87
+		case r == 59: // [';',';']
+			return 87
+		case r == 60: // ['<','<']
+			return 87
+		case r == 61: // ['=','=']
+			return 87
+		case r == 62: // ['>','>']
+			return 87
+		case r == 63: // ['?','?']
+			return 87
+		case r == 64: // ['@','@']
+			return 87
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 41
+			return 90
+		case r == 91: // ['[','[']
+			return 87
+		case r == 93: // [']',']']
+			return 87
+		case r == 94: // ['^','^']
+			return 87
+		case r == 95: // ['_','_']
+			return 87
+		case r == 96: // ['`','`']
+			return 87
 		case 97 <= r && r <= 122: // ['a','z']
-			return 19
+			return 91
+		case r == 123: // ['{','{']
+			return 87
+		case r == 124: // ['|','|']
+			return 87
+		case r == 125: // ['}','}']
+			return 87
+		case r == 126: // ['~','~']
+			return 87
+		case r == 193: // [\u00c1,\u00c1]
+			return 87
+		case r == 201: // [\u00c9,\u00c9]
+			return 87
+		case r == 205: // [\u00cd,\u00cd]
+			return 87
+		case r == 209: // [\u00d1,\u00d1]
+			return 87
+		case r == 211: // [\u00d3,\u00d3]
+			return 87
+		case r == 216: // [\u00d8,\u00d8]
+			return 87
+		case r == 218: // [\u00da,\u00da]
+			return 87
+		case r == 220: // [\u00dc,\u00dc]
+			return 87
+		case r == 225: // [\u00e1,\u00e1]
+			return 87
+		case r == 233: // [\u00e9,\u00e9]
+			return 87
+		case r == 237: // [\u00ed,\u00ed]
+			return 87
+		case r == 241: // [\u00f1,\u00f1]
+			return 87
+		case r == 243: // [\u00f3,\u00f3]
+			return 87
+		case r == 248: // [\u00f8,\u00f8]
+			return 87
+		case r == 250: // [\u00fa,\u00fa]
+			return 87
+		case r == 252: // [\u00fc,\u00fc]
+			return 87
 		}
 		return NoState
 	},
-	// S41
+	// S51
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
-		case 65 <= r && r <= 90: // ['A','Z']
-			return 41
-		case 97 <= r && r <= 122: // ['a','z']
-			return 19
+			return 92
 		}
 		return NoState
 	},
-	// S42
+	// S52
 	func(r rune) int {
 		switch {
-		case 48 <= r && r <= 57: // ['0','9']
-			return 40
-		case 65 <= r && r <= 90: // ['A','Z']
-			return 41
-		case 97 <= r && r <= 122: // ['a','z']
-			return 19
 		}
 		return NoState
 	},
-	// S43
+	// S53
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S54
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S55
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 55
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 41
-		case 97 <= r && r <= 114: // ['a','r']
-			return 19
-		case r == 115: // ['s','s']
-			return 62
-		case 116 <= r && r <= 122: // ['t','z']
-			return 19
+			return 56
+		case 97 <= r && r <= 122: // ['a','z']
+			return 27
 		}
 		return NoState
 	},
-	// S44
+	// S56
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 55
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 41
-		case 97 <= r && r <= 111: // ['a','o']
-			return 19
-		case r == 112: // ['p','p']
-			return 63
-		case 113 <= r && r <= 122: // ['q','z']
-			return 19
+			return 56
+		case 97 <= r && r <= 122: // ['a','z']
+			return 27
 		}
 		return NoState
 	},
-	// S45
+	// S57
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 55
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 41
+			return 56
 		case 97 <= r && r <= 99: // ['a','c']
-			return 19
+			return 27
 		case r == 100: // ['d','d']
-			return 64
+			return 93
 		case 101 <= r && r <= 122: // ['e','z']
-			return 19
+			return 27
 		}
 		return NoState
 	},
-	// S46
+	// S58
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 55
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 41
+			return 56
 		case 97 <= r && r <= 110: // ['a','n']
-			return 19
+			return 27
 		case r == 111: // ['o','o']
-			return 65
+			return 94
 		case 112 <= r && r <= 122: // ['p','z']
-			return 19
+			return 27
 		}
 		return NoState
 	},
-	// S47
+	// S59
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 55
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 41
-		case 97 <= r && r <= 122: // ['a','z']
-			return 19
+			return 56
+		case 97 <= r && r <= 100: // ['a','d']
+			return 27
+		case r == 101: // ['e','e']
+			return 95
+		case 102 <= r && r <= 122: // ['f','z']
+			return 27
 		}
 		return NoState
 	},
-	// S48
+	// S60
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 55
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 41
-		case 97 <= r && r <= 115: // ['a','s']
-			return 19
-		case r == 116: // ['t','t']
-			return 66
-		case 117 <= r && r <= 122: // ['u','z']
-			return 19
-		}
-		return NoState
-	},
-	// S49
+			return 56
+		case 97 <= r && r <= 114: // ['a','r']
+			return 27
+		case r == 115: // ['s','s']
+			return 96
+		case 116 <= r && r <= 122: // ['t','z']
+			return 27
+		}
+		return NoState
+	},
+	// S61
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 55
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 41
-		case 97 <= r && r <= 104: // ['a','h']
-			return 19
-		case r == 105: // ['i','i']
-			return 67
-		case 106 <= r && r <= 122: // ['j','z']
-			return 19
+			return 56
+		case 97 <= r && r <= 109: // ['a','m']
+			return 27
+		case r == 110: // ['n','n']
+			return 97
+		case 111 <= r && r <= 122: // ['o','z']
+			return 27
 		}
 		return NoState
 	},
-	// S50
+	// S62
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 55
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 41
-		case 97 <= r && r <= 104: // ['a','h']
-			return 19
-		case r == 105: // ['i','i']
-			return 68
-		case 106 <= r && r <= 110: // ['j','n']
-			return 19
-		case r == 111: // ['o','o']
-			return 69
-		case 112 <= r && r <= 122: // ['p','z']
-			return 19
+			return 56
+		case 97 <= r && r <= 101: // ['a','e']
+			return 27
+		case r == 102: // ['f','f']
+			return 98
+		case 103 <= r && r <= 122: // ['g','z']
+			return 27
 		}
 		return NoState
 	},
-	// S51
+	// S63
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 55
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 41
-		case 97 <= r && r <= 115: // ['a','s']
-			return 19
-		case r == 116: // ['t','t']
-			return 70
-		case 117 <= r && r <= 122: // ['u','z']
-			return 19
+			return 56
+		case 97 <= r && r <= 122: // ['a','z']
+			return 27
 		}
 		return NoState
 	},
-	// S52
+	// S64
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 55
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 41
-		case 97 <= r && r <= 113: // ['a','q']
-			return 19
-		case r == 114: // ['r','r']
-			return 71
-		case 115 <= r && r <= 122: // ['s','z']
-			return 19
+			return 56
+		case 97 <= r && r <= 114: // ['a','r']
+			return 27
+		case r == 115: // ['s','s']
+			return 99
+		case 116 <= r && r <= 122: // ['t','z']
+			return 27
 		}
 		return NoState
 	},
-	// S53
+	// S65
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 55
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 41
-		case 97 <= r && r <= 104: // ['a','h']
-			return 19
-		case r == 105: // ['i','i']
-			return 72
-		case 106 <= r && r <= 122: // ['j','z']
-			return 19
+			return 56
+		case 97 <= r && r <= 111: // ['a','o']
+			return 27
+		case r == 112: // ['p','p']
+			return 100
+		case 113 <= r && r <= 122: // ['q','z']
+			return 27
 		}
 		return NoState
 	},
-	// S54
+	// S66
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 55
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 41
-		case 97 <= r && r <= 104: // ['a','h']
-			return 19
-		case r == 105: // ['i','i']
-			return 73
-		case 106 <= r && r <= 122: // ['j','z']
-			return 19
+			return 56
+		case 97 <= r && r <= 99: // ['a','c']
+			return 27
+		case r == 100: // ['d','d']
+			return 101
+		case 101 <= r && r <= 122: // ['e','z']
+			return 27
 		}
 		return NoState
 	},
-	// S55
+	// S67
 	func(r rune) int {
 		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 55
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 56
+		case 97 <= r && r <= 107: // ['a','k']
+			return 27
+		case r == 108: // ['l','l']
+			return 102
+		case 109 <= r && r <= 122: // ['m','z']
+			return 27
 		}
 		return NoState
 	},
-	// S56
+	// S68
 	func(r rune) int {
 		switch {
-		case r == 9: // ['\t','\t']
-			return 55
-		case r == 10: // ['\n','\n']
-			return 55
-		case r == 13: // ['\r','\r']
+		case 48 <= r && r <= 57: // ['0','9']
 			return 55
-		case r == 32: // [' ',' ']
-			return 56
-		case r == 33: // ['!','!']
-			return 56
-		case r == 35: // ['#','#']
-			return 56
-		case r == 36: // ['$','$']
-			return 56
-		case r == 37: // ['%','%']
-			return 56
-		case r == 38: // ['&','&']
-			return 56
-		case r == 39: // [''',''']
-			return 57
-		case r == 41: // [')',')']
-			return 56
-		case r == 42: // ['*','*']
-			return 56
-		case r == 43: // ['+','+']
-			return 56
-		case r == 44: // [',',',']
-			return 56
-		case r == 45: // ['-','-']
-			return 56
-		case r == 46: // ['.','.']
-			return 56
-		case r == 47: // ['/','/']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 56
+		case 97 <= r && r <= 110: // ['a','n']
+			return 27
+		case r == 111: // ['o','o']
+			return 103
+		case 112 <= r && r <= 122: // ['p','z']
+			return 27
+		}
+		return NoState
+	},
+	// S69
+	func(r rune) int {
+		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 58
-		case r == 58: // [':',':']
-			return 56
-		case r == 59: // [';',';']
-			return 56
-		case r == 60: // ['<','<']
-			return 56
-		case r == 61: // ['=','=']
-			return 56
-		case r == 62: // ['>','>']
-			return 56
-		case r == 63: // ['?','?']
-			return 56
-		case r == 64: // ['@','@']
-			return 56
+			return 55
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 59
-		case r == 91: // ['[','[']
-			return 56
-		case r == 93: // [']',']']
-			return 56
-		case r == 94: // ['^','^']
-			return 56
-		case r == 95: // ['_','_']
-			return 56
-		case r == 96: // ['`','`']
-			return 56
-		case 97 <= r && r <= 122: // ['a','z']
-			return 60
-		case r == 123: // ['{','{']
-			return 56
-		case r == 124: // ['|','|']
-			return 56
-		case r == 125: // ['}','}']
-			return 56
-		case r == 126: // ['~','~']
-			return 56
-		case r == 193: // [\u00c1,\u00c1]
-			return 56
-		case r == 201: // [\u00c9,\u00c9]
-			return 56
-		case r == 205: // [\u00cd,\u00cd]
-			return 56
-		case r == 209: // [\u00d1,\u00d1]
-			return 56
-		case r == 211: // [\u00d3,\u00d3]
-			return 56
-		case r == 216: // [\u00d8,\u00d8]
-			return 56
-		case r == 218: // [\u00da,\u00da]
-			return 56
-		case r == 220: // [\u00dc,\u00dc]
-			return 56
-		case r == 225: // [\u00e1,\u00e1]
-			return 56
-		case r == 233: // [\u00e9,\u00e9]
-			return 56
-		case r == 237: // [\u00ed,\u00ed]
-			return 56
-		case r == 241: // [\u00f1,\u00f1]
-			return 56
-		case r == 243: // [\u00f3,\u00f3]
-			return 56
-		case r == 248: // [\u00f8,\u00f8]
-			return 56
-		case r == 250: // [\u00fa,\u00fa]
-			return 56
-		case r == 252: // [\u00fc,\u00fc]
 			return 56
+		case 97 <= r && r <= 113: // ['a','q']
+			return 27
+		case r == 114: // ['r','r']
+			return 104
+		case 115 <= r && r <= 122: // ['s','z']
+			return 27
 		}
 		return NoState
 	},
-	// S57
+	// S70
 	func(r rune) int {
 		switch {
-		case r == 40: // ['(','(']
+		case 48 <= r && r <= 57: // ['0','9']
+			return 55
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 56
+		case 97 <= r && r <= 122: // ['a','z']
+			return 27
 		}
 		return NoState
 	},
-	// S58
+	// S71
 	func(r rune) int {
 		switch {
-		case r == 9: // ['\t','\t']
-			return 55
-		case r == 10: // ['\n','\n']
+		case 48 <= r && r <= 57: // ['0','9']
 			return 55
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 56
+		case 97 <= r && r <= 115: // ['a','s']
+			return 27
+		case r == 116: // ['t','t']
+			return 105
+		case 117 <= r && r <= 122: // ['u','z']
+			return 27
+		}
+		return NoState
+	},
+	// S72
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 55
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 56
+		case 97 <= r && r <= 109: // ['a','m']
+			return 27
+		case r == 110: // ['n','n']
+			return 106
+		case 111 <= r && r <= 122: // ['o','z']
+			return 27
+		}
+		return NoState
+	},
+	// S73
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 55
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 56
+		case 97 <= r && r <= 104: // ['a','h']
+			return 27
+		case r == 105: // ['i','i']
+			return 107
+		case 106 <= r && r <= 122: // ['j','z']
+			return 27
+		}
+		return NoState
+	},
+	// S74
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 55
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 56
+		case 97 <= r && r <= 115: // ['a','s']
+			return 27
+		case r == 116: // ['t','t']
+			return 108
+		case 117 <= r && r <= 122: // ['u','z']
+			return 27
+		}
+		return NoState
+	},
+	// S75
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 55
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 56
+		case 97 <= r && r <= 122: // ['a','z']
+			return 27
+		}
+		return NoState
+	},
+	// S76
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 55
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 56
+		case 97 <= r && r <= 104: // ['a','h']
+			return 27
+		case r == 105: // ['i','i']
+			return 109
+		case 106 <= r && r <= 110: // ['j','n']
+			return 27
+		case r == 111: // ['o','o']
+			return 110
+		case 112 <= r && r <= 122: // ['p','z']
+			return 27
+		}
+		return NoState
+	},
+	// S77
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 55
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 56
+		case r == 97: // ['a','a']
+			return 111
+		case 98 <= r && r <= 115: // ['b','s']
+			return 27
+		case r == 116: // ['t','t']
+			return 112
+		case 117 <= r && r <= 122: // ['u','z']
+			return 27
+		}
+		return NoState
+	},
+	// S78
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 55
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 56
+		case 97 <= r && r <= 100: // ['a','d']
+			return 27
+		case r == 101: // ['e','e']
+			return 113
+		case 102 <= r && r <= 113: // ['f','q']
+			return 27
+		case r == 114: // ['r','r']
+			return 114
+		case 115 <= r && r <= 122: // ['s','z']
+			return 27
+		}
+		return NoState
+	},
+	// S79
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 55
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 56
+		case 97 <= r && r <= 104: // ['a','h']
+			return 27
+		case r == 105: // ['i','i']
+			return 115
+		case 106 <= r && r <= 122: // ['j','z']
+			return 27
+		}
+		return NoState
+	},
+	// S80
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 55
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 56
+		case 97 <= r && r <= 122: // ['a','z']
+			return 27
+		}
+		return NoState
+	},
+	// S81
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 55
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 56
+		case 97 <= r && r <= 116: // ['a','t']
+			return 27
+		case r == 117: // ['u','u']
+			return 116
+		case 118 <= r && r <= 122: // ['v','z']
+			return 27
+		}
+		return NoState
+	},
+	// S82
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 55
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 56
+		case 97 <= r && r <= 113: // ['a','q']
+			return 27
+		case r == 114: // ['r','r']
+			return 117
+		case 115 <= r && r <= 122: // ['s','z']
+			return 27
+		}
+		return NoState
+	},
+	// S83
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 55
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 56
+		case 97 <= r && r <= 104: // ['a','h']
+			return 27
+		case r == 105: // ['i','i']
+			return 118
+		case 106 <= r && r <= 122: // ['j','z']
+			return 27
+		}
+		return NoState
+	},
+	// S84
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 55
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 56
+		case 97 <= r && r <= 104: // ['a','h']
+			return 27
+		case r == 105: // ['i','i']
+			return 119
+		case 106 <= r && r <= 122: // ['j','z']
+			return 27
+		}
+		return NoState
+	},
+	// S85
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S86
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S87
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 86
+		case r == 10: // ['\n','\n']
+			return 86
+		case r == 13: // ['\r','\r']
+			return 86
+		case r == 32: // [' ',' ']
+			return 87
+		case r == 33: // ['!','!']
+			return 87
+		case r == 35: // ['#','#']
+			return 87
+		case r == 36: // ['$','$']
+			return 87
+		case r == 37: // ['%','%']
+			return 87
+		case r == 38: // ['&','&']
+			return 87
+		case r == 39: // [''',''']
+			return 88
+		case r == 41: // [')',')']
+			return 87
+		case r == 42: // ['*','*']
+			return 87
+		case r == 43: // ['+','+']
+			return 87
+		case r == 44: // [',',',']
+			return 87
+		case r == 45: // ['-','-']
+			return 87
+		case r == 46: // ['.','.']
+			return 87
+		case r == 47: // ['/','/']
+			return 87
+		case 48 <= r && r <= 57: // ['0','9']
+			return 89
+		case r == 58: // [':',':']
+			return 87
+		case r == 59: // [';',';']
+			return 87
+		case r == 60: // ['<','<']
+			return 87
+		case r == 61: // ['=','=']
+			return 87
+		case r == 62: // ['>','>']
+			return 87
+		case r == 63: // ['?','?']
+			return 87
+		case r == 64: // ['@','@']
+			return 87
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 90
+		case r == 91: // ['[','[']
+			return 87
+		case r == 93: // [']',']']
+			return 87
+		case r == 94: // ['^','^']
+			return 87
+		case r == 95: // ['_','_']
+			return 87
+		case r == 96: // ['`','`']
+			return 87
+		case 97 <= r && r <= 122: // ['a','z']
+			return 91
+		case r == 123: // ['{','{']
+			return 87
+		case r == 124: // ['|','|']
+			return 87
+		case r == 125: // ['}','}']
+			return 87
+		case r == 126: // ['~','~']
+			return 87
+		case r == 193: // [\u00c1,\u00c1]
+			return 87
+		case r == 201: // [\u00c9,\u00c9]
+			return 87
+		case r == 205: // [\u00cd,\u00cd]
+			return 87
+		case r == 209: // [\u00d1,\u00d1]
+			return 87
+		case r == 211: // [\u00d3,\u00d3]
+			return 87
+		case r == 216: // [\u00d8,\u00d8]
+			return 87
+		case r == 218: // [\u00da,\u00da]
+			return 87
+		case r == 220: // [\u00dc,\u00dc]
+			return 87
+		case r == 225: // [\u00e1,\u00e1]
+			return 87
+		case r == 233: // [\u00e9,\u00e9]
+			return 87
+		case r == 237: // [\u00ed,\u00ed]
+			return 87
+		case r == 241: // [\u00f1,\u00f1]
+			return 87
+		case r == 243: // [\u00f3,\u00f3]
+			return 87
+		case r == 248: // [\u00f8,\u00f8]
+			return 87
+		case r == 250: // [\u00fa,\u00fa]
+			return 87
+		case r == 252: // [\u00fc,\u00fc]
+			return 87
+		}
+		return NoState
+	},
+	// S88
+	func(r rune) int {
+		switch {
+		case r == 40: // ['(','(']
+			return 87
+		}
+		return NoState
+	},
+	// S89
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 86
+		case r == 10: // ['\n','\n']
+			return 86
 		case r == 13: // ['\r','\r']
-			return 55
+			return 86
 		case r == 32: // [' ',' ']
-			return 56
+			return 87
 		case r == 33: // ['!','!']
-			return 56
+			return 87
 		case r == 35: // ['#','#']
-			return 56
+			return 87
 		case r == 36: // ['$','$']
-			return 56
+			return 87
 		case r == 37: // ['%','%']
-			return 56
+			return 87
 		case r == 38: // ['&','&']
-			return 56
+			return 87
 		case r == 39: // [''',''']
-			return 57
+			return 88
 		case r == 41: // [')',')']
-			return 56
+			return 87
 		case r == 42: // ['*','*']
-			return 56
+			return 87
 		case r == 43: // ['+','+']
-			return 56
+			return 87
 		case r == 44: // [',',',']
-			return 56
+			return 87
 		case r == 45: // ['-','-']
-			return 56
+			return 87
 		case r == 46: // ['.','.']
-			return 56
+			return 87
 		case r == 47: // ['/','/']
-			return 56
+			return 87
 		case 48 <= r && r <= 57: // ['0','9']
-			return 58
+			return 89
 		case r == 58: // [':',':']
-			return 56
+			return 87
 		case r == 59: // [';',';']
-			return 56
+			return 87
 		case r == 60: // ['<','<']
-			return 56
+			return 87
 		case r == 61: // ['=','=']
-			return 56
+			return 87
 		case r == 62: // ['>','>']
-			return 56
+			return 87
 		case r == 63: // ['?','?']
-			return 56
+			return 87
 		case r == 64: // ['@','@']
-			return 56
+			return 87
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 59
+			return 90
 		case r == 91: // ['[','[']
-			return 56
+			return 87
 		case r == 93: // [']',']']
-			return 56
+			return 87
 		case r == 94: // ['^','^']
-			return 56
+			return 87
 		case r == 95: // ['_','_']
-			return 56
+			return 87
 		case r == 96: // ['`','`']
-			return 56
+			return 87
 		case 97 <= r && r <= 122: // ['a','z']
-			return 60
+			return 91
 		case r == 123: // ['{','{']
-			return 56
+			return 87
 		case r == 124: // ['|','|']
-			return 56
+			return 87
+		case r == 125: // ['}','}']
+			return 87
+		case r == 126: // ['~','~']
+			return 87
+		case r == 193: // [\u00c1,\u00c1]
+			return 87
+		case r == 201: // [\u00c9,\u00c9]
+			return 87
+		case r == 205: // [\u00cd,\u00cd]
+			return 87
+		case r == 209: // [\u00d1,\u00d1]
+			return 87
+		case r == 211: // [\u00d3,\u00d3]
+			return 87
+		case r == 216: // [\u00d8,\u00d8]
+			return 87
+		case r == 218: // [\u00da,\u00da]
+			return 87
+		case r == 220: // [\u00dc,\u00dc]
+			return 87
+		case r == 225: // [\u00e1,\u00e1]
+			return 87
+		case r == 233: // [\u00e9,\u00e9]
+			return 87
+		case r == 237: // [\u00ed,\u00ed]
+			return 87
+		case r == 241: // [\u00f1,\u00f1]
+			return 87
+		case r == 243: // [\u00f3,\u00f3]
+			return 87
+		case r == 248: // [\u00f8,\u00f8]
+			return 87
+		case r == 250: // [\u00fa,\u00fa]
+			return 87
+		case r == 252: // [\u00fc,\u00fc]
+			return 87
+		}
+		return NoState
+	},
+	// S90
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 86
+		case r == 10: // ['\n','\n']
+			return 86
+		case r == 13: // ['\r','\r']
+			return 86
+		case r == 32: // [' ',' ']
+			return 87
+		case r == 33: // ['!','!']
+			return 87
+		case r == 35: // ['#','#']
+			return 87
+		case r == 36: // ['$','$']
+			return 87
+		case r == 37: // ['%','%']
+			return 87
+		case r == 38: // ['&','&']
+			return 87
+		case r == 39: // [''',''']
+			return 88
+		case r == 41: // [')',')']
+			return 87
+		case r == 42: // ['*','*']
+			return 87
+		case r == 43: // ['+','+']
+			return 87
+		case r == 44: // [',',',']
+			return 87
+		case r == 45: // ['-','-']
+			return 87
+		case r == 46: // ['.','.']
+			return 87
+		case r == 47: // ['/','/']
+			return 87
+		case 48 <= r && r <= 57: // ['0','9']
+			return 89
+		case r == 58: // [':',':']
+			return 87
+		case r == 59: // [';',';']
+			return 87
+		case r == 60: // ['<','<']
+			return 87
+		case r == 61: // ['=','=']
+			return 87
+		case r == 62: // ['>','>']
+			return 87
+		case r == 63: // ['?','?']
+			return 87
+		case r == 64: // ['@','@']
+			return 87
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 90
+		case r == 91: // ['[','[']
+			return 87
+		case r == 93: // [']',']']
+			return 87
+		case r == 94: // ['^','^']
+			return 87
+		case r == 95: // ['_','_']
+			return 87
+		case r == 96: // ['`','`']
+			return 87
+		case 97 <= r && r <= 122: // ['a','z']
+			return 91
+		case r == 123: // ['{','{']
+			return 87
+		case r == 124: // ['|','|']
+			return 87
 		case r == 125: // ['}','}']
-			return 56
+			return 87
 		case r == 126: // ['~','~']
-			return 56
+			return 87
 		case r == 193: // [\u00c1,\u00c1]
-			return 56
+			return 87
 		case r == 201: // [\u00c9,\u00c9]
-			return 56
+			return 87
 		case r == 205: // [\u00cd,\u00cd]
-			return 56
+			return 87
 		case r == 209: // [\u00d1,\u00d1]
-			return 56
+			return 87
 		case r == 211: // [\u00d3,\u00d3]
-			return 56
+			return 87
 		case r == 216: // [\u00d8,\u00d8]
-			return 56
+			return 87
 		case r == 218: // [\u00da,\u00da]
-			return 56
+			return 87
 		case r == 220: // [\u00dc,\u00dc]
-			return 56
+			return 87
 		case r == 225: // [\u00e1,\u00e1]
-			return 56
+			return 87
 		case r == 233: // [\u00e9,\u00e9]
-			return 56
+			return 87
 		case r == 237: // [\u00ed,\u00ed]
-			return 56
+			return 87
 		case r == 241: // [\u00f1,\u00f1]
-			return 56
+			return 87
 		case r == 243: // [\u00f3,\u00f3]
-			return 56
+			return 87
 		case r == 248: // [\u00f8,\u00f8]
-			return 56
+			return 87
 		case r == 250: // [\u00fa,\u00fa]
-			return 56
+			return 87
 		case r == 252: // [\u00fc,\u00fc]
-			return 56
+			return 87
 		}
 		return NoState
 	},
-	// S59
+	// S91
 	func(r rune) int {
 		switch {
 		case r == 9: // ['\t','\t']
-			return 55
+			return 86
 		case r == 10: // ['\n','\n']
-			return 55
+			return 86
 		case r == 13: // ['\r','\r']
-			return 55
+			return 86
 		case r == 32: // [' ',' ']
-			return 56
+			return 87
 		case r == 33: // ['!','!']
-			return 56
+			return 87
 		case r == 35: // ['#','#']
-			return 56
+			return 87
 		case r == 36: // ['$','$']
-			return 56
+			return 87
 		case r == 37: // ['%','%']
-			return 56
+			return 87
 		case r == 38: // ['&','&']
-			return 56
+			return 87
 		case r == 39: // [''',''']
-			return 57
+			return 88
 		case r == 41: // [')',')']
-			return 56
+			return 87
 		case r == 42: // ['*','*']
-			return 56
+			return 87
 		case r == 43: // ['+','+']
-			return 56
+			return 87
 		case r == 44: // [',',',']
-			return 56
+			return 87
 		case r == 45: // ['-','-']
-			return 56
+			return 87
 		case r == 46: // ['.','.']
-			return 56
+			return 87
 		case r == 47: // ['/','/']
-			return 56
+			return 87
 		case 48 <= r && r <= 57: // ['0','9']
-			return 58
+			return 89
 		case r == 58: // [':',':']
-			return 56
+			return 87
 		case r == 59: // [';',';']
-			return 56
+			return 87
 		case r == 60: // ['<','<']
-			return 56
+			return 87
 		case r == 61: // ['=','=']
-			return 56
+			return 87
 		case r == 62: // ['>','>']
-			return 56
+			return 87
 		case r == 63: // ['?','?']
-			return 56
+			return 87
 		case r == 64: // ['@','@']
-			return 56
+			return 87
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 59
+			return 90
 		case r == 91: // ['[','[']
-			return 56
+			return 87
 		case r == 93: // [']',']']
-			return 56
+			return 87
 		case r == 94: // ['^','^']
-			return 56
+			return 87
 		case r == 95: // ['_','_']
-			return 56
+			return 87
 		case r == 96: // ['`','`']
-			return 56
+			return 87
 		case 97 <= r && r <= 122: // ['a','z']
-			return 60
+			return 91
 		case r == 123: // ['{','{']
-			return 56
+			return 87
 		case r == 124: // ['|','|']
-			return 56
+			return 87
 		case r == 125: // ['}','}']
-			return 56
+			return 87
 		case r == 126: // ['~','~']
-			return 56
+			return 87
 		case r == 193: // [\u00c1,\u00c1]
-			return 56
+			return 87
 		case r == 201: // [\u00c9,\u00c9]
-			return 56
+			return 87
 		case r == 205: // [\u00cd,\u00cd]
-			return 56
+			return 87
 		case r == 209: // [\u00d1,\u00d1]
-			return 56
+			return 87
 		case r == 211: // [\u00d3,\u00d3]
-			return 56
+			return 87
 		case r == 216: // [\u00d8,\u00d8]
-			return 56
+			return 87
 		case r == 218: // [\u00da,\u00da]
-			return 56
+			return 87
 		case r == 220: // [\u00dc,\u00dc]
-			return 56
+			return 87
 		case r == 225: // [\u00e1,\u00e1]
-			return 56
+			return 87
 		case r == 233: // [\u00e9,\u00e9]
-			return 56
+			return 87
 		case r == 237: // [\u00ed,\u00ed]
-			return 56
+			return 87
 		case r == 241: // [\u00f1,\u00f1]
-			return 56
+			return 87
 		case r == 243: // [\u00f3,\u00f3]
-			return 56
+			return 87
 		case r == 248: // [\u00f8,\u00f8]
-			return 56
+			return 87
 		case r == 250: // [\u00fa,\u00fa]
-			return 56
+			return 87
 		case r == 252: // [\u00fc,\u00fc]
+			return 87
+		}
+		return NoState
+	},
+	// S92
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 92
+		}
+		return NoState
+	},
+	// S93
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 55
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 56
+		case 97 <= r && r <= 122: // ['a','z']
+			return 27
+		}
+		return NoState
+	},
+	// S94
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 55
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 56
+		case 97 <= r && r <= 107: // ['a','k']
+			return 27
+		case r == 108: // ['l','l']
+			return 120
+		case 109 <= r && r <= 122: // ['m','z']
+			return 27
+		}
+		return NoState
+	},
+	// S95
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 55
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 56
+		case r == 97: // ['a','a']
+			return 121
+		case 98 <= r && r <= 122: // ['b','z']
+			return 27
+		}
+		return NoState
+	},
+	// S96
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 55
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 56
+		case 97 <= r && r <= 100: // ['a','d']
+			return 27
+		case r == 101: // ['e','e']
+			return 122
+		case 102 <= r && r <= 122: // ['f','z']
+			return 27
+		}
+		return NoState
+	},
+	// S97
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 55
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 56
+		case 97 <= r && r <= 115: // ['a','s']
+			return 27
+		case r == 116: // ['t','t']
+			return 123
+		case 117 <= r && r <= 122: // ['u','z']
+			return 27
+		}
+		return NoState
+	},
+	// S98
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 55
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 56
+		case r == 97: // ['a','a']
+			return 124
+		case 98 <= r && r <= 122: // ['b','z']
+			return 27
+		}
+		return NoState
+	},
+	// S99
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 55
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 56
+		case 97 <= r && r <= 100: // ['a','d']
+			return 27
+		case r == 101: // ['e','e']
+			return 125
+		case 102 <= r && r <= 122: // ['f','z']
+			return 27
+		}
+		return NoState
+	},
+	// S100
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 55
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 56
+		case 97 <= r && r <= 115: // ['a','s']
+			return 27
+		case r == 116: // ['t','t']
+			return 126
+		case 117 <= r && r <= 122: // ['u','z']
+			return 27
+		}
+		return NoState
+	},
+	// S101
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 55
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 56
+		case 97 <= r && r <= 122: // ['a','z']
+			return 27
 		}
 		return NoState
 	},
-	// S60
+	// S102
 	func(r rune) int {
 		switch {
-		case r == 9: // ['\t','\t']
-			return 55
-		case r == 10: // ['\n','\n']
-			return 55
-		case r == 13: // ['\r','\r']
+		case 48 <= r && r <= 57: // ['0','9']
 			return 55
-		case r == 32: // [' ',' ']
-			return 56
-		case r == 33: // ['!','!']
-			return 56
-		case r == 35: // ['#','#']
-			return 56
-		case r == 36: // ['$','$']
-			return 56
-		case r == 37: // ['%','%']
-			return 56
-		case r == 38: // ['&','&']
-			return 56
-		case r == 39: // [''',''']
-			return 57
-		case r == 41: // [')',')']
-			return 56
-		case r == 42: // ['*','*']
-			return 56
-		case r == 43: // ['+','+']
-			return 56
-		case r == 44: // [',',',']
-			return 56
-		case r == 45: // ['-','-']
-			return 56
-		case r == 46: // ['.','.']
-			return 56
-		case r == 47: // ['/','/']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 56
+		case 97 <= r && r <= 114: // ['a','r']
+			return 27
+		case r == 115: // ['s','s']
+			return 127
+		case 116 <= r && r <= 122: // ['t','z']
+			return 27
+		}
+		return NoState
+	},
+	// S103
+	func(r rune) int {
+		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 58
-		case r == 58: // [':',':']
-			return 56
-		case r == 59: // [';',';']
-			return 56
-		case r == 60: // ['<','<']
-			return 56
-		case r == 61: // ['=','=']
-			return 56
-		case r == 62: // ['>','>']
-			return 56
-		case r == 63: // ['?','?']
-			return 56
-		case r == 64: // ['@','@']
-			return 56
+			return 55
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 59
-		case r == 91: // ['[','[']
-			return 56
-		case r == 93: // [']',']']
 			return 56
-		case r == 94: // ['^','^']
-			return 56
-		case r == 95: // ['_','_']
+		case r == 97: // ['a','a']
+			return 128
+		case 98 <= r && r <= 122: // ['b','z']
+			return 27
+		}
+		return NoState
+	},
+	// S104
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 55
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 56
-		case r == 96: // ['`','`']
+		case 97 <= r && r <= 122: // ['a','z']
+			return 27
+		}
+		return NoState
+	},
+	// S105
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 55
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 56
 		case 97 <= r && r <= 122: // ['a','z']
-			return 60
-		case r == 123: // ['{','{']
+			return 27
+		}
+		return NoState
+	},
+	// S106
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 55
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 56
-		case r == 124: // ['|','|']
+		case 97 <= r && r <= 122: // ['a','z']
+			return 27
+		}
+		return NoState
+	},
+	// S107
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 55
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 56
-		case r == 125: // ['}','}']
+		case 97 <= r && r <= 109: // ['a','m']
+			return 27
+		case r == 110: // ['n','n']
+			return 129
+		case 111 <= r && r <= 122: // ['o','z']
+			return 27
+		}
+		return NoState
+	},
+	// S108
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 55
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 56
-		case r == 126: // ['~','~']
+		case 97 <= r && r <= 122: // ['a','z']
+			return 27
+		}
+		return NoState
+	},
+	// S109
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 55
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 56
-		case r == 193: // [\u00c1,\u00c1]
+		case 97 <= r && r <= 109: // ['a','m']
+			return 27
+		case r == 110: // ['n','n']
+			return 130
+		case 111 <= r && r <= 122: // ['o','z']
+			return 27
+		}
+		return NoState
+	},
+	// S110
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 55
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 56
-		case r == 201: // [\u00c9,\u00c9]
+		case 97 <= r && r <= 102: // ['a','f']
+			return 27
+		case r == 103: // ['g','g']
+			return 131
+		case 104 <= r && r <= 122: // ['h','z']
+			return 27
+		}
+		return NoState
+	},
+	// S111
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 55
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 56
-		case r == 205: // [\u00cd,\u00cd]
+		case 97 <= r && r <= 99: // ['a','c']
+			return 27
+		case r == 100: // ['d','d']
+			return 132
+		case 101 <= r && r <= 122: // ['e','z']
+			return 27
+		}
+		return NoState
+	},
+	// S112
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 55
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 56
-		case r == 209: // [\u00d1,\u00d1]
+		case 97 <= r && r <= 116: // ['a','t']
+			return 27
+		case r == 117: // ['u','u']
+			return 133
+		case 118 <= r && r <= 122: // ['v','z']
+			return 27
+		}
+		return NoState
+	},
+	// S113
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 55
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 56
-		case r == 211: // [\u00d3,\u00d3]
+		case 97 <= r && r <= 111: // ['a','o']
+			return 27
+		case r == 112: // ['p','p']
+			return 134
+		case 113 <= r && r <= 122: // ['q','z']
+			return 27
+		}
+		return NoState
+	},
+	// S114
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 55
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 56
-		case r == 216: // [\u00d8,\u00d8]
+		case 97 <= r && r <= 104: // ['a','h']
+			return 27
+		case r == 105: // ['i','i']
+			return 135
+		case 106 <= r && r <= 122: // ['j','z']
+			return 27
+		}
+		return NoState
+	},
+	// S115
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 55
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 56
-		case r == 218: // [\u00da,\u00da]
+		case 97 <= r && r <= 115: // ['a','s']
+			return 27
+		case r == 116: // ['t','t']
+			return 136
+		case 117 <= r && r <= 122: // ['u','z']
+			return 27
+		}
+		return NoState
+	},
+	// S116
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 55
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 56
-		case r == 220: // [\u00dc,\u00dc]
+		case 97 <= r && r <= 100: // ['a','d']
+			return 27
+		case r == 101: // ['e','e']
+			return 137
+		case 102 <= r && r <= 122: // ['f','z']
+			return 27
+		}
+		return NoState
+	},
+	// S117
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 55
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 56
-		case r == 225: // [\u00e1,\u00e1]
+		case 97 <= r && r <= 122: // ['a','z']
+			return 27
+		}
+		return NoState
+	},
+	// S118
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 55
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 56
-		case r == 233: // [\u00e9,\u00e9]
+		case 97 <= r && r <= 99: // ['a','c']
+			return 27
+		case r == 100: // ['d','d']
+			return 138
+		case 101 <= r && r <= 122: // ['e','z']
+			return 27
+		}
+		return NoState
+	},
+	// S119
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 55
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 56
-		case r == 237: // [\u00ed,\u00ed]
+		case 97 <= r && r <= 107: // ['a','k']
+			return 27
+		case r == 108: // ['l','l']
+			return 139
+		case 109 <= r && r <= 122: // ['m','z']
+			return 27
+		}
+		return NoState
+	},
+	// S120
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 55
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 56
-		case r == 241: // [\u00f1,\u00f1]
+		case 97 <= r && r <= 122: // ['a','z']
+			return 27
+		}
+		return NoState
+	},
+	// S121
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 55
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 56
-		case r == 243: // [\u00f3,\u00f3]
+		case 97 <= r && r <= 106: // ['a','j']
+			return 27
+		case r == 107: // ['k','k']
+			return 140
+		case 108 <= r && r <= 122: // ['l','z']
+			return 27
+		}
+		return NoState
+	},
+	// S122
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 55
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 56
-		case r == 248: // [\u00f8,\u00f8]
+		case 97 <= r && r <= 122: // ['a','z']
+			return 27
+		}
+		return NoState
+	},
+	// S123
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 55
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 56
-		case r == 250: // [\u00fa,\u00fa]
+		case 97 <= r && r <= 104: // ['a','h']
+			return 27
+		case r == 105: // ['i','i']
+			return 141
+		case 106 <= r && r <= 122: // ['j','z']
+			return 27
+		}
+		return NoState
+	},
+	// S124
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 55
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 56
-		case r == 252: // [\u00fc,\u00fc]
+		case 97 <= r && r <= 116: // ['a','t']
+			return 27
+		case r == 117: // ['u','u']
+			return 142
+		case 118 <= r && r <= 122: // ['v','z']
+			return 27
+		}
+		return NoState
+	},
+	// S125
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 55
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 56
+		case 97 <= r && r <= 122: // ['a','z']
+			return 27
 		}
 		return NoState
 	},
-	// S61
+	// S126
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 61
+			return 55
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 56
+		case 97 <= r && r <= 120: // ['a','x']
+			return 27
+		case r == 121: // ['y','y']
+			return 143
+		case r == 122: // ['z','z']
+			return 27
 		}
 		return NoState
 	},
-	// S62
+	// S127
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 55
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 41
+			return 56
 		case 97 <= r && r <= 100: // ['a','d']
-			return 19
+			return 27
 		case r == 101: // ['e','e']
-			return 74
+			return 144
 		case 102 <= r && r <= 122: // ['f','z']
-			return 19
+			return 27
 		}
 		return NoState
 	},
-	// S63
+	// S128
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 55
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 41
+			return 56
 		case 97 <= r && r <= 115: // ['a','s']
-			return 19
+			return 27
 		case r == 116: // ['t','t']
-			return 75
+			return 145
 		case 117 <= r && r <= 122: // ['u','z']
-			return 19
+			return 27
 		}
 		return NoState
 	},
-	// S64
+	// S129
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 55
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 41
+			return 56
 		case 97 <= r && r <= 122: // ['a','z']
-			return 19
+			return 27
 		}
 		return NoState
 	},
-	// S65
+	// S130
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 55
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 41
-		case r == 97: // ['a','a']
-			return 76
-		case 98 <= r && r <= 122: // ['b','z']
-			return 19
+			return 56
+		case 97 <= r && r <= 115: // ['a','s']
+			return 27
+		case r == 116: // ['t','t']
+			return 146
+		case 117 <= r && r <= 122: // ['u','z']
+			return 27
 		}
 		return NoState
 	},
-	// S66
+	// S131
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 55
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 41
+			return 56
+		case 97 <= r && r <= 113: // ['a','q']
+			return 27
+		case r == 114: // ['r','r']
+			return 147
+		case 115 <= r && r <= 122: // ['s','z']
+			return 27
+		}
+		return NoState
+	},
+	// S132
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 55
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 56
 		case 97 <= r && r <= 122: // ['a','z']
-			return 19
+			return 27
 		}
 		return NoState
 	},
-	// S67
+	// S133
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 55
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 41
-		case 97 <= r && r <= 109: // ['a','m']
-			return 19
-		case r == 110: // ['n','n']
-			return 77
-		case 111 <= r && r <= 122: // ['o','z']
-			return 19
+			return 56
+		case 97 <= r && r <= 113: // ['a','q']
+			return 27
+		case r == 114: // ['r','r']
+			return 148
+		case 115 <= r && r <= 122: // ['s','z']
+			return 27
 		}
 		return NoState
 	},
-	// S68
+	// S134
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 55
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 41
+			return 56
+		case 97 <= r && r <= 122: // ['a','z']
+			return 27
+		}
+		return NoState
+	},
+	// S135
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 55
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 56
 		case 97 <= r && r <= 109: // ['a','m']
-			return 19
+			return 27
 		case r == 110: // ['n','n']
-			return 78
+			return 149
 		case 111 <= r && r <= 122: // ['o','z']
-			return 19
+			return 27
 		}
 		return NoState
 	},
-	// S69
+	// S136
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 55
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 41
-		case 97 <= r && r <= 102: // ['a','f']
-			return 19
-		case r == 103: // ['g','g']
-			return 79
-		case 104 <= r && r <= 122: // ['h','z']
-			return 19
+			return 56
+		case 97 <= r && r <= 98: // ['a','b']
+			return 27
+		case r == 99: // ['c','c']
+			return 150
+		case 100 <= r && r <= 122: // ['d','z']
+			return 27
 		}
 		return NoState
 	},
-	// S70
+	// S137
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 55
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 41
-		case 97 <= r && r <= 116: // ['a','t']
-			return 19
-		case r == 117: // ['u','u']
-			return 80
-		case 118 <= r && r <= 122: // ['v','z']
-			return 19
+			return 56
+		case 97 <= r && r <= 122: // ['a','z']
+			return 27
+		}
+		return NoState
+	},
+	// S138
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 55
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 56
+		case 97 <= r && r <= 122: // ['a','z']
+			return 27
+		}
+		return NoState
+	},
+	// S139
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 55
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 56
+		case 97 <= r && r <= 100: // ['a','d']
+			return 27
+		case r == 101: // ['e','e']
+			return 151
+		case 102 <= r && r <= 122: // ['f','z']
+			return 27
 		}
 		return NoState
 	},
-	// S71
+	// S140
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 55
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 41
+			return 56
 		case 97 <= r && r <= 122: // ['a','z']
-			return 19
+			return 27
 		}
 		return NoState
 	},
-	// S72
+	// S141
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 55
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 41
-		case 97 <= r && r <= 99: // ['a','c']
-			return 19
-		case r == 100: // ['d','d']
-			return 81
-		case 101 <= r && r <= 122: // ['e','z']
-			return 19
+			return 56
+		case 97 <= r && r <= 109: // ['a','m']
+			return 27
+		case r == 110: // ['n','n']
+			return 152
+		case 111 <= r && r <= 122: // ['o','z']
+			return 27
 		}
 		return NoState
 	},
-	// S73
+	// S142
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 55
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 41
+			return 56
 		case 97 <= r && r <= 107: // ['a','k']
-			return 19
+			return 27
 		case r == 108: // ['l','l']
-			return 82
+			return 153
 		case 109 <= r && r <= 122: // ['m','z']
-			return 19
+			return 27
 		}
 		return NoState
 	},
-	// S74
+	// S143
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 55
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 41
+			return 56
 		case 97 <= r && r <= 122: // ['a','z']
-			return 19
+			return 27
 		}
 		return NoState
 	},
-	// S75
+	// S144
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 55
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 41
-		case 97 <= r && r <= 120: // ['a','x']
-			return 19
-		case r == 121: // ['y','y']
-			return 83
-		case r == 122: // ['z','z']
-			return 19
+			return 56
+		case 97 <= r && r <= 122: // ['a','z']
+			return 27
 		}
 		return NoState
 	},
-	// S76
+	// S145
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 55
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 41
-		case 97 <= r && r <= 115: // ['a','s']
-			return 19
-		case r == 116: // ['t','t']
-			return 84
-		case 117 <= r && r <= 122: // ['u','z']
-			return 19
+			return 56
+		case 97 <= r && r <= 122: // ['a','z']
+			return 27
 		}
 		return NoState
 	},
-	// S77
+	// S146
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 55
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 41
+			return 56
 		case 97 <= r && r <= 122: // ['a','z']
-			return 19
+			return 27
 		}
 		return NoState
 	},
-	// S78
+	// S147
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 55
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 41
-		case 97 <= r && r <= 115: // ['a','s']
-			return 19
-		case r == 116: // ['t','t']
-			return 85
-		case 117 <= r && r <= 122: // ['u','z']
-			return 19
+			return 56
+		case r == 97: // ['a','a']
+			return 154
+		case 98 <= r && r <= 122: // ['b','z']
+			return 27
 		}
 		return NoState
 	},
-	// S79
+	// S148
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 55
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 41
-		case 97 <= r && r <= 113: // ['a','q']
-			return 19
-		case r == 114: // ['r','r']
-			return 86
-		case 115 <= r && r <= 122: // ['s','z']
-			return 19
+			return 56
+		case 97 <= r && r <= 109: // ['a','m']
+			return 27
+		case r == 110: // ['n','n']
+			return 155
+		case 111 <= r && r <= 122: // ['o','z']
+			return 27
 		}
 		return NoState
 	},
-	// S80
+	// S149
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 55
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 41
-		case 97 <= r && r <= 113: // ['a','q']
-			return 19
-		case r == 114: // ['r','r']
-			return 87
-		case 115 <= r && r <= 122: // ['s','z']
-			return 19
+			return 56
+		case 97 <= r && r <= 102: // ['a','f']
+			return 27
+		case r == 103: // ['g','g']
+			return 156
+		case 104 <= r && r <= 122: // ['h','z']
+			return 27
 		}
 		return NoState
 	},
-	// S81
+	// S150
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 55
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 41
+			return 56
+		case 97 <= r && r <= 103: // ['a','g']
+			return 27
+		case r == 104: // ['h','h']
+			return 157
+		case 105 <= r && r <= 122: // ['i','z']
+			return 27
+		}
+		return NoState
+	},
+	// S151
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 55
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 56
 		case 97 <= r && r <= 122: // ['a','z']
-			return 19
+			return 27
 		}
 		return NoState
 	},
-	// S82
+	// S152
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 55
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 41
-		case 97 <= r && r <= 100: // ['a','d']
-			return 19
-		case r == 101: // ['e','e']
-			return 88
-		case 102 <= r && r <= 122: // ['f','z']
-			return 19
+			return 56
+		case 97 <= r && r <= 116: // ['a','t']
+			return 27
+		case r == 117: // ['u','u']
+			return 158
+		case 118 <= r && r <= 122: // ['v','z']
+			return 27
 		}
 		return NoState
 	},
-	// S83
+	// S153
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 55
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 41
-		case 97 <= r && r <= 122: // ['a','z']
-			return 19
+			return 56
+		case 97 <= r && r <= 115: // ['a','s']
+			return 27
+		case r == 116: // ['t','t']
+			return 159
+		case 117 <= r && r <= 122: // ['u','z']
+			return 27
 		}
 		return NoState
 	},
-	// S84
+	// S154
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 55
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 41
-		case 97 <= r && r <= 122: // ['a','z']
-			return 19
+			return 56
+		case 97 <= r && r <= 108: // ['a','l']
+			return 27
+		case r == 109: // ['m','m']
+			return 160
+		case 110 <= r && r <= 122: // ['n','z']
+			return 27
 		}
 		return NoState
 	},
-	// S85
+	// S155
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 55
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 41
+			return 56
 		case 97 <= r && r <= 122: // ['a','z']
-			return 19
+			return 27
 		}
 		return NoState
 	},
-	// S86
+	// S156
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 55
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 41
-		case r == 97: // ['a','a']
-			return 89
-		case 98 <= r && r <= 122: // ['b','z']
-			return 19
+			return 56
+		case 97 <= r && r <= 122: // ['a','z']
+			return 27
 		}
 		return NoState
 	},
-	// S87
+	// S157
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 55
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 41
-		case 97 <= r && r <= 109: // ['a','m']
-			return 19
-		case r == 110: // ['n','n']
-			return 90
-		case 111 <= r && r <= 122: // ['o','z']
-			return 19
+			return 56
+		case 97 <= r && r <= 122: // ['a','z']
+			return 27
 		}
 		return NoState
 	},
-	// S88
+	// S158
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 55
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 41
-		case 97 <= r && r <= 122: // ['a','z']
-			return 19
+			return 56
+		case 97 <= r && r <= 100: // ['a','d']
+			return 27
+		case r == 101: // ['e','e']
+			return 161
+		case 102 <= r && r <= 122: // ['f','z']
+			return 27
 		}
 		return NoState
 	},
-	// S89
+	// S159
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 55
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 41
-		case 97 <= r && r <= 108: // ['a','l']
-			return 19
-		case r == 109: // ['m','m']
-			return 91
-		case 110 <= r && r <= 122: // ['n','z']
-			return 19
+			return 56
+		case 97 <= r && r <= 122: // ['a','z']
+			return 27
 		}
 		return NoState
 	},
-	// S90
+	// S160
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 55
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 41
+			return 56
 		case 97 <= r && r <= 122: // ['a','z']
-			return 19
+			return 27
 		}
 		return NoState
 	},
-	// S91
+	// S161
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 55
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 41
+			return 56
 		case 97 <= r && r <= 122: // ['a','z']
-			return 19
+			return 27
 		}
 		return NoState
 	},
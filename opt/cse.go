@@ -0,0 +1,234 @@
+package opt
+
+import (
+	"BabyDuck/ast"
+	"BabyDuck/cfg"
+)
+
+// Elimina las subexpresiones comunes y propaga las copias dentro de cada
+// bloque básico con numeración de valores: cada dirección se asocia al
+// número del valor que guarda, y una operación que se repite sobre los mismos
+// valores se reemplaza por la dirección que ya tiene el resultado. Los usos
+// de un temporal se reemplazan por la dirección más antigua con el mismo
+// valor, y se eliminan los cuádruplos que dejan de usarse
+func EliminateCommonSubexpressions(ct *ast.Compilation) (Stats, error) {
+	var stats Stats
+	graphs := cfg.Build(ct)
+	remove := make([]bool, len(ct.Quads))
+	for _, b := range blocks(graphs) {
+		n := newNumbering(ct)
+		for i := b.Start; i < b.End; i++ {
+			q := &ct.Quads[i]
+
+			// Reemplazar los operandos por la dirección más antigua con el
+			// mismo valor
+			for _, operand := range reads(q) {
+				if addr := n.holder(*operand); addr != *operand {
+					*operand = addr
+					stats.Propagated++
+				}
+			}
+
+			switch q.Operator {
+			case ast.GOSUB, ast.RETURN:
+				// La función llamada puede modificar las variables globales
+				// y los valores de retorno
+				n.killGlobals()
+				continue
+			case ast.ASSIGN:
+				if n.isPointer(q.Result) {
+					// Escritura en un elemento de arreglo
+					n.killVariables()
+					continue
+				}
+				value := n.valueOf(q.Left)
+				n.set(q.Result, value)
+				if n.isValueTemp(q.Result) {
+					// Los usos del temporal leen la dirección copiada
+					remove[i] = true
+				}
+				continue
+			case ast.READ:
+				if n.isPointer(q.Result) {
+					n.killVariables()
+					continue
+				}
+				n.set(q.Result, n.newValue())
+				continue
+			}
+
+			key, ok := n.key(q)
+			if !ok {
+				continue
+			}
+
+			// Reutilizar el resultado de la misma operación sobre los mismos
+			// valores si alguna dirección todavía lo guarda
+			if value, found := n.exprs[key]; found {
+				if addr := n.holderOf(value); addr >= 0 {
+					stats.Eliminated++
+					if n.isValueTemp(q.Result) {
+						remove[i] = true
+					} else {
+						*q = ast.Quadruple{Operator: ast.ASSIGN, Left: addr, Right: -1, Result: q.Result, Pos: q.Pos}
+					}
+					n.set(q.Result, value)
+					continue
+				}
+			}
+
+			value := n.newValue()
+			n.exprs[key] = value
+			n.set(q.Result, value)
+		}
+	}
+
+	// Conservar los cuádruplos cuyo resultado todavía se usa
+	keepUsed(ct.Quads, graphs, remove)
+	stats.Removed = removeQuads(ct, remove)
+
+	return stats, nil
+}
+
+// Operación sobre los números de valor de sus operandos
+type exprKey struct {
+	op, left, right int
+}
+
+// Numeración de valores de un bloque básico
+type numbering struct {
+	alloc   *ast.Allocator
+	consts  map[int]bool
+	values  map[int]int     // Número del valor que guarda cada dirección
+	holders map[int][]int   // Direcciones que recibieron cada valor, en orden
+	exprs   map[exprKey]int // Número del valor de cada operación calculada
+	next    int
+}
+
+// Crea una numeración vacía para un bloque
+func newNumbering(ct *ast.Compilation) *numbering {
+	n := &numbering{
+		alloc:   ct.Compiler.Alloc,
+		consts:  map[int]bool{},
+		values:  map[int]int{},
+		holders: map[int][]int{},
+		exprs:   map[exprKey]int{},
+	}
+	for _, node := range ct.Compiler.Memory.Const.GetAll() {
+		n.consts[node.Address] = true
+	}
+	return n
+}
+
+// Obtiene un número de valor nuevo
+func (n *numbering) newValue() int {
+	n.next++
+	return n.next
+}
+
+// Obtiene el número del valor que guarda una dirección; el contenido de un
+// apuntador nunca se numera porque el elemento puede cambiar sin escribir
+// en el apuntador
+func (n *numbering) valueOf(addr int) int {
+	if n.isPointer(addr) {
+		return n.newValue()
+	}
+	if value, ok := n.values[addr]; ok {
+		return value
+	}
+	value := n.newValue()
+	n.set(addr, value)
+	return value
+}
+
+// Registra que una dirección guarda un valor
+func (n *numbering) set(addr, value int) {
+	n.values[addr] = value
+	n.holders[value] = append(n.holders[value], addr)
+}
+
+// Obtiene la dirección que guarda un valor, prefiriendo una constante y
+// después la más antigua (-1 si ninguna lo guarda)
+func (n *numbering) holderOf(value int) int {
+	result := -1
+	for _, addr := range n.holders[value] {
+		if n.values[addr] != value {
+			continue
+		}
+		if n.consts[addr] {
+			return addr
+		}
+		if result < 0 {
+			result = addr
+		}
+	}
+	return result
+}
+
+// Obtiene la dirección que reemplaza la lectura de otra
+func (n *numbering) holder(addr int) int {
+	if n.isPointer(addr) {
+		return addr
+	}
+	if found := n.holderOf(n.valueOf(addr)); found >= 0 {
+		return found
+	}
+	return addr
+}
+
+// Obtiene la llave de una operación sin efectos; los operandos de las
+// operaciones conmutativas se ordenan. La suma de cadenas es una
+// concatenación, por lo que solo es conmutativa entre números
+func (n *numbering) key(q *ast.Quadruple) (exprKey, bool) {
+	switch q.Operator {
+	case ast.NOT, ast.LEN:
+		return exprKey{q.Operator, n.valueOf(q.Left), -1}, true
+	case ast.MINUS, ast.DIVIDE, ast.MOD, ast.GT, ast.LT, ast.LTE, ast.GTE:
+		return exprKey{q.Operator, n.valueOf(q.Left), n.valueOf(q.Right)}, true
+	case ast.PLUS, ast.TIMES, ast.EQ, ast.NEQ, ast.AND, ast.OR:
+		left, right := n.valueOf(q.Left), n.valueOf(q.Right)
+		commutative := (q.Operator != ast.PLUS && q.Operator != ast.TIMES) ||
+			(n.isNumber(q.Left) && n.isNumber(q.Right))
+		if commutative && right < left {
+			left, right = right, left
+		}
+		return exprKey{q.Operator, left, right}, true
+	}
+	return exprKey{}, false
+}
+
+// Olvida los valores de las variables globales, incluidos los valores de
+// retorno de las funciones
+func (n *numbering) killGlobals() {
+	for addr := range n.values {
+		if n.alloc.IsGlobal(addr) {
+			delete(n.values, addr)
+		}
+	}
+}
+
+// Olvida los valores de todas las variables; los temporales y las
+// constantes no cambian
+func (n *numbering) killVariables() {
+	for addr := range n.values {
+		if !n.consts[addr] && !n.alloc.IsTemp(addr) {
+			delete(n.values, addr)
+		}
+	}
+}
+
+// Indica si una dirección es un temporal que guarda un valor
+func (n *numbering) isValueTemp(addr int) bool {
+	return n.alloc.IsTemp(addr) && !n.isPointer(addr)
+}
+
+// Indica si una dirección es un apuntador a un elemento de arreglo
+func (n *numbering) isPointer(addr int) bool {
+	return n.alloc.TypeOf(addr) == "pointer"
+}
+
+// Indica si una dirección guarda un número
+func (n *numbering) isNumber(addr int) bool {
+	typ := n.alloc.TypeOf(addr)
+	return typ == "int" || typ == "float"
+}
@@ -0,0 +1,214 @@
+package opt
+
+import (
+	"BabyDuck/ast"
+	"BabyDuck/cfg"
+)
+
+// Evalúa al compilar las operaciones entre constantes y simplifica las
+// identidades algebraicas (x + 0, x - 0, x * 1, 1 * x, x / 1) dentro de cada
+// bloque básico. Los usos de un temporal con valor conocido se reemplazan por
+// la constante o el temporal equivalente, y se eliminan los cuádruplos que
+// dejan de usarse
+func FoldConstants(ct *ast.Compilation) (Stats, error) {
+	f := &folder{
+		ct:     ct,
+		consts: map[int]ast.Value{},
+	}
+	for _, node := range ct.Compiler.Memory.Const.GetAll() {
+		f.consts[node.Address] = node.Value
+	}
+
+	var stats Stats
+	graphs := cfg.Build(ct)
+	remove := make([]bool, len(ct.Quads))
+	for _, b := range blocks(graphs) {
+		// Temporales con valor conocido dentro del bloque y la dirección
+		// que los reemplaza
+		known := map[int]int{}
+		for i := b.Start; i < b.End; i++ {
+			q := &ct.Quads[i]
+
+			// Reemplazar los operandos con valor conocido
+			for _, operand := range reads(q) {
+				if addr, ok := known[*operand]; ok {
+					*operand = addr
+				}
+			}
+
+			// El destino deja de tener un valor conocido
+			dest, ok := defines(q)
+			if !ok {
+				continue
+			}
+			forget(known, dest)
+
+			// Intentar reemplazar el resultado
+			replacement, kind, err := f.simplify(q)
+			if err != nil {
+				return stats, err
+			}
+			switch kind {
+			case folded:
+				stats.Folded++
+			case simplified:
+				stats.Simplified++
+			}
+			switch {
+			case replacement < 0:
+			case f.isValueTemp(dest):
+				known[dest] = replacement
+				remove[i] = true
+			case kind != copied:
+				// El resultado es una variable; asignarle el valor directamente
+				*q = ast.Quadruple{Operator: ast.ASSIGN, Left: replacement, Right: -1, Result: dest, Pos: q.Pos}
+			}
+		}
+	}
+
+	// Conservar los cuádruplos cuyo resultado todavía se usa
+	keepUsed(ct.Quads, graphs, remove)
+	stats.Removed = removeQuads(ct, remove)
+
+	return stats, nil
+}
+
+// Resultado de simplificar un cuádruplo
+const (
+	unchanged  = iota
+	folded     // Operación entre constantes evaluada
+	simplified // Identidad algebraica aplicada
+	copied     // Copia de una constante o un temporal
+)
+
+// Estado del plegado de constantes de una compilación
+type folder struct {
+	ct     *ast.Compilation
+	consts map[int]ast.Value // Valor de cada dirección constante
+}
+
+// Intenta simplificar un cuádruplo; devuelve la dirección equivalente a su
+// resultado (-1 si no la hay). Las identidades sobre variables se reescriben
+// como una asignación
+func (f *folder) simplify(q *ast.Quadruple) (int, int, error) {
+	alloc := f.ct.Compiler.Alloc
+
+	switch q.Operator {
+	case ast.ASSIGN:
+		// Copia de una constante o de un temporal
+		if _, ok := f.consts[q.Left]; ok || f.isValueTemp(q.Left) {
+			return q.Left, copied, nil
+		}
+		return -1, unchanged, nil
+
+	case ast.NOT, ast.LEN:
+		left, ok := f.consts[q.Left]
+		if !ok {
+			return -1, unchanged, nil
+		}
+		value, _ := ast.Fold(q.Operator, left, ast.Value{})
+		addr, err := f.constAddress(value)
+		return addr, folded, err
+	}
+
+	// Operaciones binarias entre constantes
+	left, leftConst := f.consts[q.Left]
+	right, rightConst := f.consts[q.Right]
+	if leftConst && rightConst {
+		value, ok := ast.Fold(q.Operator, left, right)
+		if !ok {
+			// El resultado depende de las opciones de ejecución
+			return -1, unchanged, nil
+		}
+		addr, err := f.constAddress(value)
+		return addr, folded, err
+	}
+
+	// Identidades algebraicas; los tres operandos deben ser del mismo tipo
+	// para no cambiar el tipo del resultado
+	var x int
+	var c ast.Value
+	switch {
+	case rightConst && identity(q.Operator, right, false):
+		x, c = q.Left, right
+	case leftConst && identity(q.Operator, left, true):
+		x, c = q.Right, left
+	default:
+		return -1, unchanged, nil
+	}
+	typ := alloc.TypeOf(x)
+	if typ != typeName(c) || typ != alloc.TypeOf(q.Result) {
+		return -1, unchanged, nil
+	}
+	if f.isValueTemp(x) {
+		return x, simplified, nil
+	}
+	*q = ast.Quadruple{Operator: ast.ASSIGN, Left: x, Right: -1, Result: q.Result, Pos: q.Pos}
+	return -1, simplified, nil
+}
+
+// Indica si una constante es el elemento neutro de una operación; left
+// indica si la constante es el operando izquierdo
+func identity(op int, c ast.Value, left bool) bool {
+	switch op {
+	case ast.PLUS:
+		// -0.0 + 0.0 es 0.0, por lo que solo aplica a enteros
+		return c == ast.IntValue(0)
+	case ast.MINUS:
+		return !left && (c == ast.IntValue(0) || c == ast.FloatValue(0))
+	case ast.TIMES:
+		return c == ast.IntValue(1) || c == ast.FloatValue(1)
+	case ast.DIVIDE:
+		return !left && (c == ast.IntValue(1) || c == ast.FloatValue(1))
+	}
+	return false
+}
+
+// Indica si una dirección es un temporal que guarda un valor (no un
+// apuntador a un elemento de arreglo)
+func (f *folder) isValueTemp(addr int) bool {
+	alloc := f.ct.Compiler.Alloc
+	return alloc.IsTemp(addr) && alloc.TypeOf(addr) != "pointer"
+}
+
+// Obtiene la dirección de una constante, reutilizando la tabla de
+// constantes y declarándola si no existe
+func (f *folder) constAddress(value ast.Value) (int, error) {
+	c := f.ct.Compiler
+	typ := typeName(value)
+	if node, found := c.Memory.Const.FindConst(typ, value); found {
+		return node.Address, nil
+	}
+
+	node := &ast.VarNode{Type: typ, Value: value}
+	if err := c.DeclareVariable(node); err != nil {
+		return 0, err
+	}
+	f.consts[node.Address] = value
+	return node.Address, nil
+}
+
+// Elimina lo que se sabe de una dirección que acaba de escribirse
+func forget(known map[int]int, addr int) {
+	delete(known, addr)
+	for temp, replacement := range known {
+		if replacement == addr {
+			delete(known, temp)
+		}
+	}
+}
+
+// Obtiene el nombre del tipo de un valor
+func typeName(value ast.Value) string {
+	switch value.Kind {
+	case ast.KindInt:
+		return "int"
+	case ast.KindFloat:
+		return "float"
+	case ast.KindBool:
+		return "bool"
+	case ast.KindString:
+		return "string"
+	}
+	return ""
+}
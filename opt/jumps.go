@@ -0,0 +1,92 @@
+package opt
+
+import (
+	"BabyDuck/ast"
+	"BabyDuck/cfg"
+)
+
+// Simplifica los saltos y elimina el código inalcanzable. Los saltos a un
+// GOTO se redirigen a su destino final después de convertir en GOTO los
+// GOTOF con una condición constante, y se quitan los bloques que no se
+// alcanzan desde el inicio de su función y los saltos al cuádruplo siguiente
+func RemoveDeadCode(ct *ast.Compilation) (Stats, error) {
+	var stats Stats
+	quads := ct.Quads
+
+	// Convertir los GOTOF cuya condición es una constante en un GOTO a su
+	// destino si es falsa o al cuádruplo siguiente si es verdadera
+	consts := map[int]ast.Value{}
+	for _, node := range ct.Compiler.Memory.Const.GetAll() {
+		consts[node.Address] = node.Value
+	}
+	for i := range quads {
+		q := &quads[i]
+		cond, ok := consts[q.Left]
+		if q.Operator != ast.GOTOF || !ok {
+			continue
+		}
+		target := q.Result
+		if cond.Bool {
+			target = i + 1
+		}
+		*q = ast.Quadruple{Operator: ast.GOTO, Left: -1, Right: -1, Result: target, Pos: q.Pos}
+	}
+
+	// Redirigir las cadenas de GOTO a su destino final
+	for i := range quads {
+		q := &quads[i]
+		if q.Operator != ast.GOTO && q.Operator != ast.GOTOF {
+			continue
+		}
+		if target := finalTarget(quads, q.Result); target != q.Result {
+			q.Result = target
+			stats.Threaded++
+		}
+	}
+
+	// Eliminar los bloques inalcanzables desde el inicio de su función
+	remove := make([]bool, len(quads))
+	for _, b := range blocks(cfg.Build(ct)) {
+		if b.Reachable {
+			continue
+		}
+		for i := b.Start; i < b.End; i++ {
+			remove[i] = true
+		}
+	}
+
+	// Eliminar los saltos al cuádruplo siguiente; se recorre de atrás hacia
+	// adelante para que quitar un salto permita quitar el anterior
+	next := len(quads)
+	for i := len(quads) - 1; i >= 0; i-- {
+		if remove[i] {
+			continue
+		}
+		q := quads[i]
+		if (q.Operator == ast.GOTO || q.Operator == ast.GOTOF) && keptFrom(remove, q.Result) == next {
+			remove[i] = true
+			continue
+		}
+		next = i
+	}
+
+	stats.Removed = removeQuads(ct, remove)
+	return stats, nil
+}
+
+// Sigue una cadena de GOTO hasta el primer cuádruplo que no lo es; se
+// detiene si la cadena forma un ciclo
+func finalTarget(quads []ast.Quadruple, target int) int {
+	for steps := 0; target < len(quads) && quads[target].Operator == ast.GOTO && steps < len(quads); steps++ {
+		target = quads[target].Result
+	}
+	return target
+}
+
+// Obtiene el primer cuádruplo a partir de i que no se elimina
+func keptFrom(remove []bool, i int) int {
+	for i < len(remove) && remove[i] {
+		i++
+	}
+	return i
+}
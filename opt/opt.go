@@ -0,0 +1,173 @@
+package opt
+
+import (
+	"BabyDuck/ast"
+	"BabyDuck/cfg"
+	"fmt"
+)
+
+// Resultado de las optimizaciones aplicadas a un programa
+type Stats struct {
+	Folded     int // Operaciones entre constantes evaluadas al compilar
+	Simplified int // Operaciones simplificadas con identidades algebraicas
+	Eliminated int // Operaciones repetidas reemplazadas por un resultado anterior
+	Propagated int // Operandos reemplazados por la dirección que guarda su valor
+	Threaded   int // Saltos redirigidos al final de una cadena de GOTO
+	Removed    int // Cuádruplos eliminados
+}
+
+// Acumula el resultado de otra optimización
+func (s *Stats) Add(other Stats) {
+	s.Folded += other.Folded
+	s.Simplified += other.Simplified
+	s.Eliminated += other.Eliminated
+	s.Propagated += other.Propagated
+	s.Threaded += other.Threaded
+	s.Removed += other.Removed
+}
+
+// Da formato al resultado de las optimizaciones
+func (s Stats) String() string {
+	return fmt.Sprintf("%d cuádruplos eliminados (%d operaciones constantes, %d simplificaciones, %d subexpresiones comunes, %d copias propagadas, %d saltos redirigidos)",
+		s.Removed, s.Folded, s.Simplified, s.Eliminated, s.Propagated, s.Threaded)
+}
+
+// Aplica todas las optimizaciones al código intermedio de una compilación;
+// debe llamarse después de generar el código y antes de crear el Runtime
+func Optimize(ct *ast.Compilation) (Stats, error) {
+	var stats Stats
+
+	folded, err := FoldConstants(ct)
+	if err != nil {
+		return stats, err
+	}
+	stats.Add(folded)
+
+	// Reutilizar los cálculos repetidos y propagar las copias, incluidas las
+	// que deja el plegado de constantes
+	common, err := EliminateCommonSubexpressions(ct)
+	if err != nil {
+		return stats, err
+	}
+	stats.Add(common)
+
+	// Eliminar el código inalcanzable después de resolver las condiciones
+	// constantes
+	dead, err := RemoveDeadCode(ct)
+	if err != nil {
+		return stats, err
+	}
+	stats.Add(dead)
+
+	return stats, nil
+}
+
+// Obtiene todos los bloques básicos de los grafos
+func blocks(graphs []*cfg.Graph) []*cfg.Block {
+	var result []*cfg.Block
+	for _, g := range graphs {
+		result = append(result, g.Blocks...)
+	}
+	return result
+}
+
+// Desmarca los cuádruplos cuyo resultado se lee en algún cuádruplo que se
+// conserva de la misma función
+func keepUsed(quads []ast.Quadruple, graphs []*cfg.Graph, remove []bool) {
+	for _, g := range graphs {
+		// Contar los usos de cada dirección en los cuádruplos conservados
+		uses := map[int]int{}
+		count := func(i int) {
+			for _, operand := range reads(&quads[i]) {
+				uses[*operand]++
+			}
+		}
+		for i := g.Start; i < g.End; i++ {
+			if !remove[i] {
+				count(i)
+			}
+		}
+
+		// Conservar los cuádruplos usados hasta que no haya cambios, ya que
+		// conservar uno agrega los usos de sus operandos
+		for changed := true; changed; {
+			changed = false
+			for i := g.Start; i < g.End; i++ {
+				if remove[i] && uses[quads[i].Result] > 0 {
+					remove[i] = false
+					count(i)
+					changed = true
+				}
+			}
+		}
+	}
+}
+
+// Obtiene los operandos que un cuádruplo lee como valores
+func reads(q *ast.Quadruple) []*int {
+	switch q.Operator {
+	case ast.PLUS, ast.MINUS, ast.TIMES, ast.DIVIDE, ast.MOD,
+		ast.GT, ast.LT, ast.NEQ, ast.EQ, ast.LTE, ast.GTE,
+		ast.AND, ast.OR, ast.VER:
+		return []*int{&q.Left, &q.Right}
+	case ast.ASSIGN, ast.PRINT, ast.GOTOF, ast.PARAM, ast.RETURN,
+		ast.NOT, ast.LEN, ast.ADDR:
+		return []*int{&q.Left}
+	}
+	return nil
+}
+
+// Obtiene la dirección que escribe un cuádruplo
+func defines(q *ast.Quadruple) (int, bool) {
+	switch q.Operator {
+	case ast.PLUS, ast.MINUS, ast.TIMES, ast.DIVIDE, ast.MOD,
+		ast.GT, ast.LT, ast.NEQ, ast.EQ, ast.LTE, ast.GTE,
+		ast.AND, ast.OR, ast.NOT, ast.LEN,
+		ast.ASSIGN, ast.ADDR, ast.READ:
+		return q.Result, true
+	}
+	return 0, false
+}
+
+// Elimina los cuádruplos marcados y actualiza los destinos de salto y el
+// inicio de cada función; un salto a un cuádruplo eliminado pasa al
+// siguiente que se conserva. Devuelve la cantidad de cuádruplos eliminados
+func removeQuads(ct *ast.Compilation, remove []bool) int {
+	// Calcular la nueva posición de cada cuádruplo
+	newIndex := make([]int, len(ct.Quads)+1)
+	kept := 0
+	for i := range ct.Quads {
+		newIndex[i] = kept
+		if !remove[i] {
+			kept++
+		}
+	}
+	newIndex[len(ct.Quads)] = kept
+	removed := len(ct.Quads) - kept
+	if removed == 0 {
+		return 0
+	}
+
+	// Compactar la lista y actualizar los destinos de salto
+	quads := make([]ast.Quadruple, 0, kept)
+	for i, q := range ct.Quads {
+		if remove[i] {
+			continue
+		}
+		switch q.Operator {
+		case ast.GOTO, ast.GOTOF:
+			q.Result = newIndex[q.Result]
+		case ast.ERA, ast.GOSUB:
+			q.Left = newIndex[q.Left]
+		}
+		quads = append(quads, q)
+	}
+	ct.Quads = quads
+
+	// Actualizar el inicio de las funciones
+	for _, funcNode := range ct.Compiler.FuncDir {
+		funcNode.QuadStart = newIndex[funcNode.QuadStart]
+	}
+
+	return removed
+}
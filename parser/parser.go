@@ -11,9 +11,9 @@ import (
 )
 
 const (
-	numProductions = 70
-	numStates      = 296
-	numSymbols     = 73
+	numProductions = 123
+	numStates      = 988
+	numSymbols     = 118
 )
 
 // Stack
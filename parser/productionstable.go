@@ -5,6 +5,7 @@ package parser
 import(
     "BabyDuck/ast"
     "BabyDuck/token"
+    "strconv"
 )
 
 type (
@@ -40,11 +41,12 @@ var productionsTable = ProdTab{
             body := X[6].([]ast.Attrib)
 
             // Crear nodo del programa
-            programNode := ast.ProgramNode{
+            programNode := &ast.ProgramNode{
                 Id: id,
                 Vars: vars,
                 Funcs: funcs,
                 Body: body,
+                Pos: X[0].(*token.Token).Pos,
             }
 
             return programNode, nil
@@ -61,11 +63,12 @@ var productionsTable = ProdTab{
             body := X[6].([]ast.Attrib)
 
             // Crear nodo del programa
-            programNode := ast.ProgramNode{
+            programNode := &ast.ProgramNode{
                 Id: id,
                 Vars: vars,
                 Funcs: funcs,
                 Body: body,
+                Pos: X[0].(*token.Token).Pos,
             }
 
             return programNode, nil
@@ -113,9 +116,10 @@ var productionsTable = ProdTab{
 		},
 	},
 	ProdTabEntry{
-		String: `VarDeclaration : IdList colon Type semicolon	<< func() (Attrib, error) {
+		String: `VarDeclaration : IdList colon Type Dims semicolon	<< func() (Attrib, error) {
             ids := X[0].([]*token.Token)
             typ := string(X[2].(*token.Token).Lit)
+            dims := X[3].([]int)
             vars := []*ast.VarNode{}
             
             // Juntar ids en la lista de variables
@@ -123,6 +127,8 @@ var productionsTable = ProdTab{
                 vars = append(vars, &ast.VarNode{
                     Id: string(id.Lit),
                     Type: typ,
+                    Dims: dims,
+                    Pos: id.Pos,
                     },
                 )
             }
@@ -132,11 +138,12 @@ var productionsTable = ProdTab{
 		Id:         "VarDeclaration",
 		NTType:     4,
 		Index:      6,
-		NumSymbols: 4,
+		NumSymbols: 5,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return func() (Attrib, error) {
             ids := X[0].([]*token.Token)
             typ := string(X[2].(*token.Token).Lit)
+            dims := X[3].([]int)
             vars := []*ast.VarNode{}
             
             // Juntar ids en la lista de variables
@@ -144,6 +151,8 @@ var productionsTable = ProdTab{
                 vars = append(vars, &ast.VarNode{
                     Id: string(id.Lit),
                     Type: typ,
+                    Dims: dims,
+                    Pos: id.Pos,
                     },
                 )
             }
@@ -153,10 +162,44 @@ var productionsTable = ProdTab{
 		},
 	},
 	ProdTabEntry{
-		String: `IdList : id comma IdList	<< append([]*token.Token{X[0].(*token.Token)}, X[2].([]*token.Token)...), nil >>`,
-		Id:         "IdList",
+		String: `Dims : lbracket cte_int rbracket Dims	<< func() (Attrib, error) {
+            // Convertir el tamaño de la dimensión a entero
+            size, err := strconv.Atoi(string(X[1].(*token.Token).Lit))
+            if err != nil {
+                return nil, err
+            }
+            return append([]int{size}, X[3].([]int)...), nil
+        }() >>`,
+		Id:         "Dims",
 		NTType:     5,
 		Index:      7,
+		NumSymbols: 4,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return func() (Attrib, error) {
+            // Convertir el tamaño de la dimensión a entero
+            size, err := strconv.Atoi(string(X[1].(*token.Token).Lit))
+            if err != nil {
+                return nil, err
+            }
+            return append([]int{size}, X[3].([]int)...), nil
+        }()
+		},
+	},
+	ProdTabEntry{
+		String: `Dims : "empty"	<< []int(nil), nil >>`,
+		Id:         "Dims",
+		NTType:     5,
+		Index:      8,
+		NumSymbols: 0,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return []int(nil), nil
+		},
+	},
+	ProdTabEntry{
+		String: `IdList : id comma IdList	<< append([]*token.Token{X[0].(*token.Token)}, X[2].([]*token.Token)...), nil >>`,
+		Id:         "IdList",
+		NTType:     6,
+		Index:      9,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return append([]*token.Token{X[0].(*token.Token)}, X[2].([]*token.Token)...), nil
@@ -165,8 +208,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `IdList : id	<< []*token.Token{X[0].(*token.Token)}, nil >>`,
 		Id:         "IdList",
-		NTType:     5,
-		Index:      8,
+		NTType:     6,
+		Index:      10,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return []*token.Token{X[0].(*token.Token)}, nil
@@ -175,8 +218,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Type : int	<< X[0], nil >>`,
 		Id:         "Type",
-		NTType:     6,
-		Index:      9,
+		NTType:     7,
+		Index:      11,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -185,8 +228,28 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Type : float	<< X[0], nil >>`,
 		Id:         "Type",
-		NTType:     6,
-		Index:      10,
+		NTType:     7,
+		Index:      12,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `Type : bool	<< X[0], nil >>`,
+		Id:         "Type",
+		NTType:     7,
+		Index:      13,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `Type : string	<< X[0], nil >>`,
+		Id:         "Type",
+		NTType:     7,
+		Index:      14,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -195,8 +258,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `FuncSection : FuncDeclaration FuncSection	<< append([]*ast.FuncNode{X[0].(*ast.FuncNode)}, X[1].([]*ast.FuncNode)...), nil >>`,
 		Id:         "FuncSection",
-		NTType:     7,
-		Index:      11,
+		NTType:     8,
+		Index:      15,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return append([]*ast.FuncNode{X[0].(*ast.FuncNode)}, X[1].([]*ast.FuncNode)...), nil
@@ -205,8 +268,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `FuncSection : "empty"	<< []*ast.FuncNode{}, nil >>`,
 		Id:         "FuncSection",
-		NTType:     7,
-		Index:      12,
+		NTType:     8,
+		Index:      16,
 		NumSymbols: 0,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return []*ast.FuncNode{}, nil
@@ -215,36 +278,54 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `FuncDeclaration : FuncType id lparen FuncParams rparen lbracket VarSection Body rbracket semicolon	<< func() (Attrib, error) {
             typ := string(X[0].(*token.Token).Lit)
-            id := string(X[1].(*token.Token).Lit)
+            id := X[1].(*token.Token)
             params := X[3].([]*ast.VarNode)
             vars := X[6].([]*ast.VarNode)
             body := X[7].([]ast.Attrib)
 
-            // Validar y registrar la función en el directorio
-            return ast.DeclareFunction(typ, id, params, vars, body)
+            // Crear el nodo de función
+            funcNode := &ast.FuncNode{
+                Id: string(id.Lit),
+                Params: params,
+                Vars: vars,
+                Body: body,
+                ReturnType: typ,
+                Pos: id.Pos,
+            }
+
+            return funcNode, nil
         }() >>`,
 		Id:         "FuncDeclaration",
-		NTType:     8,
-		Index:      13,
+		NTType:     9,
+		Index:      17,
 		NumSymbols: 10,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return func() (Attrib, error) {
             typ := string(X[0].(*token.Token).Lit)
-            id := string(X[1].(*token.Token).Lit)
+            id := X[1].(*token.Token)
             params := X[3].([]*ast.VarNode)
             vars := X[6].([]*ast.VarNode)
             body := X[7].([]ast.Attrib)
 
-            // Validar y registrar la función en el directorio
-            return ast.DeclareFunction(typ, id, params, vars, body)
+            // Crear el nodo de función
+            funcNode := &ast.FuncNode{
+                Id: string(id.Lit),
+                Params: params,
+                Vars: vars,
+                Body: body,
+                ReturnType: typ,
+                Pos: id.Pos,
+            }
+
+            return funcNode, nil
         }()
 		},
 	},
 	ProdTabEntry{
 		String: `FuncType : void	<< X[0], nil >>`,
 		Id:         "FuncType",
-		NTType:     9,
-		Index:      14,
+		NTType:     10,
+		Index:      18,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -253,8 +334,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `FuncType : int	<< X[0], nil >>`,
 		Id:         "FuncType",
-		NTType:     9,
-		Index:      15,
+		NTType:     10,
+		Index:      19,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -263,8 +344,28 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `FuncType : float	<< X[0], nil >>`,
 		Id:         "FuncType",
-		NTType:     9,
-		Index:      16,
+		NTType:     10,
+		Index:      20,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `FuncType : bool	<< X[0], nil >>`,
+		Id:         "FuncType",
+		NTType:     10,
+		Index:      21,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `FuncType : string	<< X[0], nil >>`,
+		Id:         "FuncType",
+		NTType:     10,
+		Index:      22,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -273,8 +374,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `FuncParams : ParamList	<< X[0], nil >>`,
 		Id:         "FuncParams",
-		NTType:     10,
-		Index:      17,
+		NTType:     11,
+		Index:      23,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -283,8 +384,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `FuncParams : "empty"	<< []*ast.VarNode{}, nil >>`,
 		Id:         "FuncParams",
-		NTType:     10,
-		Index:      18,
+		NTType:     11,
+		Index:      24,
 		NumSymbols: 0,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return []*ast.VarNode{}, nil
@@ -293,8 +394,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `ParamList : Param comma ParamList	<< append([]*ast.VarNode{X[0].(*ast.VarNode)}, X[2].([]*ast.VarNode)...), nil >>`,
 		Id:         "ParamList",
-		NTType:     11,
-		Index:      19,
+		NTType:     12,
+		Index:      25,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return append([]*ast.VarNode{X[0].(*ast.VarNode)}, X[2].([]*ast.VarNode)...), nil
@@ -303,8 +404,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `ParamList : Param	<< []*ast.VarNode{X[0].(*ast.VarNode)}, nil >>`,
 		Id:         "ParamList",
-		NTType:     11,
-		Index:      20,
+		NTType:     12,
+		Index:      26,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return []*ast.VarNode{X[0].(*ast.VarNode)}, nil
@@ -314,23 +415,25 @@ var productionsTable = ProdTab{
 		String: `Param : id colon Type	<< &ast.VarNode{
             Id: string(X[0].(*token.Token).Lit),
             Type: string(X[2].(*token.Token).Lit),
+            Pos: X[0].(*token.Token).Pos,
         }, nil >>`,
 		Id:         "Param",
-		NTType:     12,
-		Index:      21,
+		NTType:     13,
+		Index:      27,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return &ast.VarNode{
             Id: string(X[0].(*token.Token).Lit),
             Type: string(X[2].(*token.Token).Lit),
+            Pos: X[0].(*token.Token).Pos,
         }, nil
 		},
 	},
 	ProdTabEntry{
 		String: `Body : lbrace StatementList rbrace	<< X[1], nil >>`,
 		Id:         "Body",
-		NTType:     13,
-		Index:      22,
+		NTType:     14,
+		Index:      28,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[1], nil
@@ -339,8 +442,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `StatementList : Statement StatementList	<< append([]ast.Attrib{ X[0].(ast.Attrib) }, X[1].([]ast.Attrib)...), nil >>`,
 		Id:         "StatementList",
-		NTType:     14,
-		Index:      23,
+		NTType:     15,
+		Index:      29,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return append([]ast.Attrib{ X[0].(ast.Attrib) }, X[1].([]ast.Attrib)...), nil
@@ -349,8 +452,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `StatementList : "empty"	<< []ast.Attrib{}, nil >>`,
 		Id:         "StatementList",
-		NTType:     14,
-		Index:      24,
+		NTType:     15,
+		Index:      30,
 		NumSymbols: 0,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return []ast.Attrib{}, nil
@@ -359,8 +462,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Statement : Assign	<< X[0], nil >>`,
 		Id:         "Statement",
-		NTType:     15,
-		Index:      25,
+		NTType:     16,
+		Index:      31,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -369,8 +472,18 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Statement : Condition	<< X[0], nil >>`,
 		Id:         "Statement",
-		NTType:     15,
-		Index:      26,
+		NTType:     16,
+		Index:      32,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `Statement : Switch	<< X[0], nil >>`,
+		Id:         "Statement",
+		NTType:     16,
+		Index:      33,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -379,8 +492,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Statement : Cycle	<< X[0], nil >>`,
 		Id:         "Statement",
-		NTType:     15,
-		Index:      27,
+		NTType:     16,
+		Index:      34,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -389,8 +502,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Statement : F_Call	<< X[0], nil >>`,
 		Id:         "Statement",
-		NTType:     15,
-		Index:      28,
+		NTType:     16,
+		Index:      35,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -399,184 +512,448 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Statement : Print	<< X[0], nil >>`,
 		Id:         "Statement",
-		NTType:     15,
-		Index:      29,
+		NTType:     16,
+		Index:      36,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
 		},
 	},
 	ProdTabEntry{
-		String: `Statement : Return	<< X[0], nil >>`,
+		String: `Statement : Read	<< X[0], nil >>`,
 		Id:         "Statement",
-		NTType:     15,
-		Index:      30,
+		NTType:     16,
+		Index:      37,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
 		},
 	},
 	ProdTabEntry{
-		String: `Assign : id assign Expression semicolon	<< ast.AssignNode{
-            Id: string(X[0].(*token.Token).Lit),
-            Exp: X[2].(ast.Attrib),
-        }, nil >>`,
-		Id:         "Assign",
+		String: `Statement : Return	<< X[0], nil >>`,
+		Id:         "Statement",
 		NTType:     16,
-		Index:      31,
-		NumSymbols: 4,
+		Index:      38,
+		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return ast.AssignNode{
-            Id: string(X[0].(*token.Token).Lit),
-            Exp: X[2].(ast.Attrib),
-        }, nil
+			return X[0], nil
 		},
 	},
 	ProdTabEntry{
-		String: `Expression : Exp	<< X[0], nil >>`,
-		Id:         "Expression",
-		NTType:     17,
-		Index:      32,
+		String: `Statement : LoopJump	<< X[0], nil >>`,
+		Id:         "Statement",
+		NTType:     16,
+		Index:      39,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
 		},
 	},
 	ProdTabEntry{
-		String: `Expression : Exp RelOp Exp	<< ast.ExpressionNode{
-            Left:  X[0].(ast.Attrib),
-            Op:    X[1].(int),
-            Right: X[2].(ast.Attrib),
+		String: `Assign : id Indices assign Expression semicolon	<< &ast.AssignNode{
+            Id: string(X[0].(*token.Token).Lit),
+            Indices: X[1].([]ast.Attrib),
+            Exp: X[3].(ast.Attrib),
+            Pos: X[0].(*token.Token).Pos,
         }, nil >>`,
-		Id:         "Expression",
+		Id:         "Assign",
 		NTType:     17,
-		Index:      33,
-		NumSymbols: 3,
+		Index:      40,
+		NumSymbols: 5,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return ast.ExpressionNode{
-            Left:  X[0].(ast.Attrib),
-            Op:    X[1].(int),
-            Right: X[2].(ast.Attrib),
+			return &ast.AssignNode{
+            Id: string(X[0].(*token.Token).Lit),
+            Indices: X[1].([]ast.Attrib),
+            Exp: X[3].(ast.Attrib),
+            Pos: X[0].(*token.Token).Pos,
         }, nil
 		},
 	},
 	ProdTabEntry{
-		String: `RelOp : gt	<< ast.GT, nil >>`,
-		Id:         "RelOp",
+		String: `Indices : lbracket Expression rbracket Indices	<< append([]ast.Attrib{ X[1].(ast.Attrib) }, X[3].([]ast.Attrib)...), nil >>`,
+		Id:         "Indices",
 		NTType:     18,
-		Index:      34,
-		NumSymbols: 1,
+		Index:      41,
+		NumSymbols: 4,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return ast.GT, nil
+			return append([]ast.Attrib{ X[1].(ast.Attrib) }, X[3].([]ast.Attrib)...), nil
 		},
 	},
 	ProdTabEntry{
-		String: `RelOp : lt	<< ast.LT, nil >>`,
-		Id:         "RelOp",
+		String: `Indices : "empty"	<< []ast.Attrib(nil), nil >>`,
+		Id:         "Indices",
 		NTType:     18,
-		Index:      35,
-		NumSymbols: 1,
+		Index:      42,
+		NumSymbols: 0,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return ast.LT, nil
+			return []ast.Attrib(nil), nil
 		},
 	},
 	ProdTabEntry{
-		String: `RelOp : neq	<< ast.NEQ, nil >>`,
-		Id:         "RelOp",
-		NTType:     18,
-		Index:      36,
+		String: `Expression : OrExp	<< X[0], nil >>`,
+		Id:         "Expression",
+		NTType:     19,
+		Index:      43,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return ast.NEQ, nil
+			return X[0], nil
 		},
 	},
 	ProdTabEntry{
-		String: `Exp : Exp plus Term	<< ast.ExpressionNode{
-            Op:    ast.PLUS,
+		String: `OrExp : OrExp OrOp AndExp	<< &ast.ExpressionNode{
+            Op:    ast.OR,
             Left:  X[0].(ast.Attrib),
             Right: X[2].(ast.Attrib),
+            Pos:   X[1].(*token.Token).Pos,
         }, nil >>`,
-		Id:         "Exp",
-		NTType:     19,
-		Index:      37,
+		Id:         "OrExp",
+		NTType:     20,
+		Index:      44,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return ast.ExpressionNode{
-            Op:    ast.PLUS,
+			return &ast.ExpressionNode{
+            Op:    ast.OR,
             Left:  X[0].(ast.Attrib),
             Right: X[2].(ast.Attrib),
+            Pos:   X[1].(*token.Token).Pos,
         }, nil
 		},
 	},
 	ProdTabEntry{
-		String: `Exp : Exp minus Term	<< ast.ExpressionNode{
-            Op:    ast.MINUS,
+		String: `OrExp : AndExp	<< X[0], nil >>`,
+		Id:         "OrExp",
+		NTType:     20,
+		Index:      45,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `AndExp : AndExp AndOp NotExp	<< &ast.ExpressionNode{
+            Op:    ast.AND,
             Left:  X[0].(ast.Attrib),
             Right: X[2].(ast.Attrib),
+            Pos:   X[1].(*token.Token).Pos,
         }, nil >>`,
-		Id:         "Exp",
-		NTType:     19,
-		Index:      38,
+		Id:         "AndExp",
+		NTType:     21,
+		Index:      46,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return ast.ExpressionNode{
-            Op:    ast.MINUS,
+			return &ast.ExpressionNode{
+            Op:    ast.AND,
             Left:  X[0].(ast.Attrib),
             Right: X[2].(ast.Attrib),
+            Pos:   X[1].(*token.Token).Pos,
         }, nil
 		},
 	},
 	ProdTabEntry{
-		String: `Exp : Term	<< X[0], nil >>`,
-		Id:         "Exp",
-		NTType:     19,
-		Index:      39,
+		String: `AndExp : NotExp	<< X[0], nil >>`,
+		Id:         "AndExp",
+		NTType:     21,
+		Index:      47,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
 		},
 	},
 	ProdTabEntry{
-		String: `Term : Term times Factor	<< ast.ExpressionNode{
-            Op:    ast.TIMES,
-            Left:  X[0].(ast.Attrib),
-            Right: X[2].(ast.Attrib),
+		String: `NotExp : NotOp NotExp	<< &ast.ExpressionNode{
+            Op:   ast.NOT,
+            Left: X[1].(ast.Attrib),
+            Pos:  X[0].(*token.Token).Pos,
         }, nil >>`,
-		Id:         "Term",
-		NTType:     20,
-		Index:      40,
+		Id:         "NotExp",
+		NTType:     22,
+		Index:      48,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return &ast.ExpressionNode{
+            Op:   ast.NOT,
+            Left: X[1].(ast.Attrib),
+            Pos:  X[0].(*token.Token).Pos,
+        }, nil
+		},
+	},
+	ProdTabEntry{
+		String: `NotExp : RelExp	<< X[0], nil >>`,
+		Id:         "NotExp",
+		NTType:     22,
+		Index:      49,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `OrOp : or	<< X[0], nil >>`,
+		Id:         "OrOp",
+		NTType:     23,
+		Index:      50,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `OrOp : or_sym	<< X[0], nil >>`,
+		Id:         "OrOp",
+		NTType:     23,
+		Index:      51,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `AndOp : and	<< X[0], nil >>`,
+		Id:         "AndOp",
+		NTType:     24,
+		Index:      52,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `AndOp : and_sym	<< X[0], nil >>`,
+		Id:         "AndOp",
+		NTType:     24,
+		Index:      53,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `NotOp : not	<< X[0], nil >>`,
+		Id:         "NotOp",
+		NTType:     25,
+		Index:      54,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `NotOp : not_sym	<< X[0], nil >>`,
+		Id:         "NotOp",
+		NTType:     25,
+		Index:      55,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `RelExp : Exp	<< X[0], nil >>`,
+		Id:         "RelExp",
+		NTType:     26,
+		Index:      56,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `RelExp : Exp RelOp Exp	<< func() (Attrib, error) {
+            // Completar el nodo creado por el operador relacional
+            node := X[1].(*ast.ExpressionNode)
+            node.Left = X[0].(ast.Attrib)
+            node.Right = X[2].(ast.Attrib)
+            return node, nil
+        }() >>`,
+		Id:         "RelExp",
+		NTType:     26,
+		Index:      57,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return ast.ExpressionNode{
+			return func() (Attrib, error) {
+            // Completar el nodo creado por el operador relacional
+            node := X[1].(*ast.ExpressionNode)
+            node.Left = X[0].(ast.Attrib)
+            node.Right = X[2].(ast.Attrib)
+            return node, nil
+        }()
+		},
+	},
+	ProdTabEntry{
+		String: `RelOp : gt	<< &ast.ExpressionNode{Op: ast.GT, Pos: X[0].(*token.Token).Pos}, nil >>`,
+		Id:         "RelOp",
+		NTType:     27,
+		Index:      58,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return &ast.ExpressionNode{Op: ast.GT, Pos: X[0].(*token.Token).Pos}, nil
+		},
+	},
+	ProdTabEntry{
+		String: `RelOp : lt	<< &ast.ExpressionNode{Op: ast.LT, Pos: X[0].(*token.Token).Pos}, nil >>`,
+		Id:         "RelOp",
+		NTType:     27,
+		Index:      59,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return &ast.ExpressionNode{Op: ast.LT, Pos: X[0].(*token.Token).Pos}, nil
+		},
+	},
+	ProdTabEntry{
+		String: `RelOp : neq	<< &ast.ExpressionNode{Op: ast.NEQ, Pos: X[0].(*token.Token).Pos}, nil >>`,
+		Id:         "RelOp",
+		NTType:     27,
+		Index:      60,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return &ast.ExpressionNode{Op: ast.NEQ, Pos: X[0].(*token.Token).Pos}, nil
+		},
+	},
+	ProdTabEntry{
+		String: `RelOp : eq	<< &ast.ExpressionNode{Op: ast.EQ, Pos: X[0].(*token.Token).Pos}, nil >>`,
+		Id:         "RelOp",
+		NTType:     27,
+		Index:      61,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return &ast.ExpressionNode{Op: ast.EQ, Pos: X[0].(*token.Token).Pos}, nil
+		},
+	},
+	ProdTabEntry{
+		String: `RelOp : lte	<< &ast.ExpressionNode{Op: ast.LTE, Pos: X[0].(*token.Token).Pos}, nil >>`,
+		Id:         "RelOp",
+		NTType:     27,
+		Index:      62,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return &ast.ExpressionNode{Op: ast.LTE, Pos: X[0].(*token.Token).Pos}, nil
+		},
+	},
+	ProdTabEntry{
+		String: `RelOp : gte	<< &ast.ExpressionNode{Op: ast.GTE, Pos: X[0].(*token.Token).Pos}, nil >>`,
+		Id:         "RelOp",
+		NTType:     27,
+		Index:      63,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return &ast.ExpressionNode{Op: ast.GTE, Pos: X[0].(*token.Token).Pos}, nil
+		},
+	},
+	ProdTabEntry{
+		String: `Exp : Exp plus Term	<< &ast.ExpressionNode{
+            Op:    ast.PLUS,
+            Left:  X[0].(ast.Attrib),
+            Right: X[2].(ast.Attrib),
+            Pos:   X[1].(*token.Token).Pos,
+        }, nil >>`,
+		Id:         "Exp",
+		NTType:     28,
+		Index:      64,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return &ast.ExpressionNode{
+            Op:    ast.PLUS,
+            Left:  X[0].(ast.Attrib),
+            Right: X[2].(ast.Attrib),
+            Pos:   X[1].(*token.Token).Pos,
+        }, nil
+		},
+	},
+	ProdTabEntry{
+		String: `Exp : Exp minus Term	<< &ast.ExpressionNode{
+            Op:    ast.MINUS,
+            Left:  X[0].(ast.Attrib),
+            Right: X[2].(ast.Attrib),
+            Pos:   X[1].(*token.Token).Pos,
+        }, nil >>`,
+		Id:         "Exp",
+		NTType:     28,
+		Index:      65,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return &ast.ExpressionNode{
+            Op:    ast.MINUS,
+            Left:  X[0].(ast.Attrib),
+            Right: X[2].(ast.Attrib),
+            Pos:   X[1].(*token.Token).Pos,
+        }, nil
+		},
+	},
+	ProdTabEntry{
+		String: `Exp : Term	<< X[0], nil >>`,
+		Id:         "Exp",
+		NTType:     28,
+		Index:      66,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `Term : Term times Factor	<< &ast.ExpressionNode{
+            Op:    ast.TIMES,
+            Left:  X[0].(ast.Attrib),
+            Right: X[2].(ast.Attrib),
+            Pos:   X[1].(*token.Token).Pos,
+        }, nil >>`,
+		Id:         "Term",
+		NTType:     29,
+		Index:      67,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return &ast.ExpressionNode{
             Op:    ast.TIMES,
             Left:  X[0].(ast.Attrib),
             Right: X[2].(ast.Attrib),
+            Pos:   X[1].(*token.Token).Pos,
         }, nil
 		},
 	},
 	ProdTabEntry{
-		String: `Term : Term divide Factor	<< ast.ExpressionNode{
+		String: `Term : Term divide Factor	<< &ast.ExpressionNode{
             Op:    ast.DIVIDE,
             Left:  X[0].(ast.Attrib),
             Right: X[2].(ast.Attrib),
+            Pos:   X[1].(*token.Token).Pos,
         }, nil >>`,
 		Id:         "Term",
-		NTType:     20,
-		Index:      41,
+		NTType:     29,
+		Index:      68,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return ast.ExpressionNode{
+			return &ast.ExpressionNode{
             Op:    ast.DIVIDE,
             Left:  X[0].(ast.Attrib),
             Right: X[2].(ast.Attrib),
+            Pos:   X[1].(*token.Token).Pos,
+        }, nil
+		},
+	},
+	ProdTabEntry{
+		String: `Term : Term mod Factor	<< &ast.ExpressionNode{
+            Op:    ast.MOD,
+            Left:  X[0].(ast.Attrib),
+            Right: X[2].(ast.Attrib),
+            Pos:   X[1].(*token.Token).Pos,
+        }, nil >>`,
+		Id:         "Term",
+		NTType:     29,
+		Index:      69,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return &ast.ExpressionNode{
+            Op:    ast.MOD,
+            Left:  X[0].(ast.Attrib),
+            Right: X[2].(ast.Attrib),
+            Pos:   X[1].(*token.Token).Pos,
         }, nil
 		},
 	},
 	ProdTabEntry{
 		String: `Term : Factor	<< X[0], nil >>`,
 		Id:         "Term",
-		NTType:     20,
-		Index:      42,
+		NTType:     29,
+		Index:      70,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -585,8 +962,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Factor : Atom	<< X[0], nil >>`,
 		Id:         "Factor",
-		NTType:     21,
-		Index:      43,
+		NTType:     30,
+		Index:      71,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -595,28 +972,30 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Factor : plus Atom	<< X[1], nil >>`,
 		Id:         "Factor",
-		NTType:     21,
-		Index:      44,
+		NTType:     30,
+		Index:      72,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[1], nil
 		},
 	},
 	ProdTabEntry{
-		String: `Factor : minus ExpVar	<< ast.ExpressionNode{
+		String: `Factor : minus ExpVar	<< &ast.ExpressionNode{
             Op:    ast.MINUS,
-            Left:  &ast.VarNode{Type: "int", Value: "0"},
+            Left:  &ast.VarNode{Type: "int", Value: ast.IntValue(0), Pos: X[0].(*token.Token).Pos},
             Right: X[1].(ast.Attrib),
+            Pos:   X[0].(*token.Token).Pos,
         }, nil >>`,
 		Id:         "Factor",
-		NTType:     21,
-		Index:      45,
+		NTType:     30,
+		Index:      73,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return ast.ExpressionNode{
+			return &ast.ExpressionNode{
             Op:    ast.MINUS,
-            Left:  &ast.VarNode{Type: "int", Value: "0"},
+            Left:  &ast.VarNode{Type: "int", Value: ast.IntValue(0), Pos: X[0].(*token.Token).Pos},
             Right: X[1].(ast.Attrib),
+            Pos:   X[0].(*token.Token).Pos,
         }, nil
 		},
 	},
@@ -624,18 +1003,18 @@ var productionsTable = ProdTab{
 		String: `Factor : minus Cte	<< func() (Attrib, error) {
             // Convierte la constante a negativo
             n := X[1].(*ast.VarNode)
-            n.Value = "-" + n.Value
+            n.Value = n.Value.Negate()
             return n, nil
         }() >>`,
 		Id:         "Factor",
-		NTType:     21,
-		Index:      46,
+		NTType:     30,
+		Index:      74,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return func() (Attrib, error) {
             // Convierte la constante a negativo
             n := X[1].(*ast.VarNode)
-            n.Value = "-" + n.Value
+            n.Value = n.Value.Negate()
             return n, nil
         }()
 		},
@@ -643,8 +1022,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Atom : ExpVar	<< X[0], nil >>`,
 		Id:         "Atom",
-		NTType:     22,
-		Index:      47,
+		NTType:     31,
+		Index:      75,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -653,18 +1032,98 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Atom : Cte	<< X[0], nil >>`,
 		Id:         "Atom",
-		NTType:     22,
-		Index:      48,
+		NTType:     31,
+		Index:      76,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
 		},
 	},
+	ProdTabEntry{
+		String: `Atom : CteBool	<< X[0], nil >>`,
+		Id:         "Atom",
+		NTType:     31,
+		Index:      77,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `Atom : CteString	<< X[0], nil >>`,
+		Id:         "Atom",
+		NTType:     31,
+		Index:      78,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `CteString : cte_string	<< func() (Attrib, error) {
+            lit := string(X[0].(*token.Token).Lit)
+            return &ast.VarNode{
+                Type: "string",
+                Value: ast.StringValue(lit[1:len(lit)-1]),
+                Pos: X[0].(*token.Token).Pos,
+            }, nil
+        }() >>`,
+		Id:         "CteString",
+		NTType:     32,
+		Index:      79,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return func() (Attrib, error) {
+            lit := string(X[0].(*token.Token).Lit)
+            return &ast.VarNode{
+                Type: "string",
+                Value: ast.StringValue(lit[1:len(lit)-1]),
+                Pos: X[0].(*token.Token).Pos,
+            }, nil
+        }()
+		},
+	},
+	ProdTabEntry{
+		String: `CteBool : true	<< &ast.VarNode{
+            Type: "bool",
+            Value: ast.BoolValue(true),
+            Pos: X[0].(*token.Token).Pos,
+        }, nil >>`,
+		Id:         "CteBool",
+		NTType:     33,
+		Index:      80,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return &ast.VarNode{
+            Type: "bool",
+            Value: ast.BoolValue(true),
+            Pos: X[0].(*token.Token).Pos,
+        }, nil
+		},
+	},
+	ProdTabEntry{
+		String: `CteBool : false	<< &ast.VarNode{
+            Type: "bool",
+            Value: ast.BoolValue(false),
+            Pos: X[0].(*token.Token).Pos,
+        }, nil >>`,
+		Id:         "CteBool",
+		NTType:     33,
+		Index:      81,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return &ast.VarNode{
+            Type: "bool",
+            Value: ast.BoolValue(false),
+            Pos: X[0].(*token.Token).Pos,
+        }, nil
+		},
+	},
 	ProdTabEntry{
 		String: `ExpVar : lparen Expression rparen	<< X[1], nil >>`,
 		Id:         "ExpVar",
-		NTType:     23,
-		Index:      49,
+		NTType:     34,
+		Index:      82,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[1], nil
@@ -673,150 +1132,448 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `ExpVar : F_Return	<< X[0], nil >>`,
 		Id:         "ExpVar",
-		NTType:     23,
-		Index:      50,
+		NTType:     34,
+		Index:      83,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
 		},
 	},
 	ProdTabEntry{
-		String: `ExpVar : id	<< ast.ExpressionVar{
+		String: `ExpVar : len lparen Expression rparen	<< &ast.ExpressionNode{
+            Op:   ast.LEN,
+            Left: X[2].(ast.Attrib),
+            Pos:  X[0].(*token.Token).Pos,
+        }, nil >>`,
+		Id:         "ExpVar",
+		NTType:     34,
+		Index:      84,
+		NumSymbols: 4,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return &ast.ExpressionNode{
+            Op:   ast.LEN,
+            Left: X[2].(ast.Attrib),
+            Pos:  X[0].(*token.Token).Pos,
+        }, nil
+		},
+	},
+	ProdTabEntry{
+		String: `ExpVar : id Indices	<< &ast.ExpressionVar{
             Id: string(X[0].(*token.Token).Lit),
+            Indices: X[1].([]ast.Attrib),
+            Pos: X[0].(*token.Token).Pos,
         }, nil >>`,
 		Id:         "ExpVar",
-		NTType:     23,
-		Index:      51,
-		NumSymbols: 1,
+		NTType:     34,
+		Index:      85,
+		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return ast.ExpressionVar{
+			return &ast.ExpressionVar{
             Id: string(X[0].(*token.Token).Lit),
+            Indices: X[1].([]ast.Attrib),
+            Pos: X[0].(*token.Token).Pos,
         }, nil
 		},
 	},
 	ProdTabEntry{
-		String: `Cte : cte_int	<< &ast.VarNode{
-            Type: "int",
-            Value: string(X[0].(*token.Token).Lit),
-        }, nil >>`,
+		String: `Cte : cte_int	<< func() (Attrib, error) {
+            // Convierte el literal a entero de 64 bits
+            value, err := strconv.ParseInt(string(X[0].(*token.Token).Lit), 10, 64)
+            if err != nil {
+                return nil, err
+            }
+            return &ast.VarNode{
+                Type: "int",
+                Value: ast.IntValue(value),
+                Pos: X[0].(*token.Token).Pos,
+            }, nil
+        }() >>`,
 		Id:         "Cte",
-		NTType:     24,
-		Index:      52,
+		NTType:     35,
+		Index:      86,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return &ast.VarNode{
-            Type: "int",
-            Value: string(X[0].(*token.Token).Lit),
-        }, nil
+			return func() (Attrib, error) {
+            // Convierte el literal a entero de 64 bits
+            value, err := strconv.ParseInt(string(X[0].(*token.Token).Lit), 10, 64)
+            if err != nil {
+                return nil, err
+            }
+            return &ast.VarNode{
+                Type: "int",
+                Value: ast.IntValue(value),
+                Pos: X[0].(*token.Token).Pos,
+            }, nil
+        }()
 		},
 	},
 	ProdTabEntry{
-		String: `Cte : cte_float	<< &ast.VarNode{
-            Type: "float",
-            Value: string(X[0].(*token.Token).Lit),
-        }, nil >>`,
+		String: `Cte : cte_float	<< func() (Attrib, error) {
+            // Convierte el literal a flotante de 64 bits
+            value, err := strconv.ParseFloat(string(X[0].(*token.Token).Lit), 64)
+            if err != nil {
+                return nil, err
+            }
+            return &ast.VarNode{
+                Type: "float",
+                Value: ast.FloatValue(value),
+                Pos: X[0].(*token.Token).Pos,
+            }, nil
+        }() >>`,
 		Id:         "Cte",
-		NTType:     24,
-		Index:      53,
+		NTType:     35,
+		Index:      87,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return &ast.VarNode{
-            Type: "float",
-            Value: string(X[0].(*token.Token).Lit),
-        }, nil
+			return func() (Attrib, error) {
+            // Convierte el literal a flotante de 64 bits
+            value, err := strconv.ParseFloat(string(X[0].(*token.Token).Lit), 64)
+            if err != nil {
+                return nil, err
+            }
+            return &ast.VarNode{
+                Type: "float",
+                Value: ast.FloatValue(value),
+                Pos: X[0].(*token.Token).Pos,
+            }, nil
+        }()
 		},
 	},
 	ProdTabEntry{
-		String: `Condition : if lparen Expression rparen Body ElseOptional semicolon	<< ast.IfNode{
+		String: `Condition : if lparen Expression rparen Body ElseOptional semicolon	<< &ast.IfNode{
             Condition: X[2].(ast.Attrib),
             ThenBlock: X[4].([]ast.Attrib),
             ElseBlock: X[5].([]ast.Attrib),
+            Pos: X[0].(*token.Token).Pos,
         }, nil >>`,
 		Id:         "Condition",
-		NTType:     25,
-		Index:      54,
+		NTType:     36,
+		Index:      88,
 		NumSymbols: 7,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return ast.IfNode{
+			return &ast.IfNode{
             Condition: X[2].(ast.Attrib),
             ThenBlock: X[4].([]ast.Attrib),
             ElseBlock: X[5].([]ast.Attrib),
+            Pos: X[0].(*token.Token).Pos,
         }, nil
 		},
 	},
 	ProdTabEntry{
 		String: `ElseOptional : else Body	<< X[1], nil >>`,
 		Id:         "ElseOptional",
-		NTType:     26,
-		Index:      55,
+		NTType:     37,
+		Index:      89,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[1], nil
 		},
 	},
+	ProdTabEntry{
+		String: `ElseOptional : else if lparen Expression rparen Body ElseOptional	<< []ast.Attrib{
+            &ast.IfNode{
+                Condition: X[3].(ast.Attrib),
+                ThenBlock: X[5].([]ast.Attrib),
+                ElseBlock: X[6].([]ast.Attrib),
+                Pos: X[1].(*token.Token).Pos,
+            },
+        }, nil >>`,
+		Id:         "ElseOptional",
+		NTType:     37,
+		Index:      90,
+		NumSymbols: 7,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return []ast.Attrib{
+            &ast.IfNode{
+                Condition: X[3].(ast.Attrib),
+                ThenBlock: X[5].([]ast.Attrib),
+                ElseBlock: X[6].([]ast.Attrib),
+                Pos: X[1].(*token.Token).Pos,
+            },
+        }, nil
+		},
+	},
 	ProdTabEntry{
 		String: `ElseOptional : "empty"	<< []ast.Attrib{}, nil >>`,
 		Id:         "ElseOptional",
-		NTType:     26,
-		Index:      56,
+		NTType:     37,
+		Index:      91,
 		NumSymbols: 0,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return []ast.Attrib{}, nil
 		},
 	},
 	ProdTabEntry{
-		String: `Cycle : while lparen Expression rparen do Body semicolon	<< ast.WhileNode{
+		String: `Switch : switch lparen Expression rparen lbrace CaseList DefaultOptional rbrace semicolon	<< &ast.SwitchNode{
+            Exp: X[2].(ast.Attrib),
+            Cases: X[5].([]*ast.CaseNode),
+            Default: X[6].([]ast.Attrib),
+            Pos: X[0].(*token.Token).Pos,
+        }, nil >>`,
+		Id:         "Switch",
+		NTType:     38,
+		Index:      92,
+		NumSymbols: 9,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return &ast.SwitchNode{
+            Exp: X[2].(ast.Attrib),
+            Cases: X[5].([]*ast.CaseNode),
+            Default: X[6].([]ast.Attrib),
+            Pos: X[0].(*token.Token).Pos,
+        }, nil
+		},
+	},
+	ProdTabEntry{
+		String: `CaseList : Case CaseList	<< append([]*ast.CaseNode{ X[0].(*ast.CaseNode) }, X[1].([]*ast.CaseNode)...), nil >>`,
+		Id:         "CaseList",
+		NTType:     39,
+		Index:      93,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return append([]*ast.CaseNode{ X[0].(*ast.CaseNode) }, X[1].([]*ast.CaseNode)...), nil
+		},
+	},
+	ProdTabEntry{
+		String: `CaseList : Case	<< []*ast.CaseNode{ X[0].(*ast.CaseNode) }, nil >>`,
+		Id:         "CaseList",
+		NTType:     39,
+		Index:      94,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return []*ast.CaseNode{ X[0].(*ast.CaseNode) }, nil
+		},
+	},
+	ProdTabEntry{
+		String: `Case : case CaseValue colon StatementList	<< &ast.CaseNode{
+            Value: X[1].(*ast.VarNode),
+            Body: X[3].([]ast.Attrib),
+            Pos: X[0].(*token.Token).Pos,
+        }, nil >>`,
+		Id:         "Case",
+		NTType:     40,
+		Index:      95,
+		NumSymbols: 4,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return &ast.CaseNode{
+            Value: X[1].(*ast.VarNode),
+            Body: X[3].([]ast.Attrib),
+            Pos: X[0].(*token.Token).Pos,
+        }, nil
+		},
+	},
+	ProdTabEntry{
+		String: `CaseValue : Cte	<< X[0], nil >>`,
+		Id:         "CaseValue",
+		NTType:     41,
+		Index:      96,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `CaseValue : minus Cte	<< func() (Attrib, error) {
+            // Convierte la constante a negativo
+            n := X[1].(*ast.VarNode)
+            n.Value = n.Value.Negate()
+            return n, nil
+        }() >>`,
+		Id:         "CaseValue",
+		NTType:     41,
+		Index:      97,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return func() (Attrib, error) {
+            // Convierte la constante a negativo
+            n := X[1].(*ast.VarNode)
+            n.Value = n.Value.Negate()
+            return n, nil
+        }()
+		},
+	},
+	ProdTabEntry{
+		String: `CaseValue : CteBool	<< X[0], nil >>`,
+		Id:         "CaseValue",
+		NTType:     41,
+		Index:      98,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `CaseValue : CteString	<< X[0], nil >>`,
+		Id:         "CaseValue",
+		NTType:     41,
+		Index:      99,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `DefaultOptional : default colon StatementList	<< X[2], nil >>`,
+		Id:         "DefaultOptional",
+		NTType:     42,
+		Index:      100,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[2], nil
+		},
+	},
+	ProdTabEntry{
+		String: `DefaultOptional : "empty"	<< []ast.Attrib{}, nil >>`,
+		Id:         "DefaultOptional",
+		NTType:     42,
+		Index:      101,
+		NumSymbols: 0,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return []ast.Attrib{}, nil
+		},
+	},
+	ProdTabEntry{
+		String: `Cycle : while lparen Expression rparen do Body semicolon	<< &ast.WhileNode{
             Condition: X[2].(ast.Attrib),
             Body: X[5].([]ast.Attrib),
+            Pos: X[0].(*token.Token).Pos,
         }, nil >>`,
 		Id:         "Cycle",
-		NTType:     27,
-		Index:      57,
+		NTType:     43,
+		Index:      102,
 		NumSymbols: 7,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return ast.WhileNode{
+			return &ast.WhileNode{
             Condition: X[2].(ast.Attrib),
             Body: X[5].([]ast.Attrib),
+            Pos: X[0].(*token.Token).Pos,
+        }, nil
+		},
+	},
+	ProdTabEntry{
+		String: `Cycle : for id assign Expression to Expression ForStep do Body semicolon	<< func() (Attrib, error) {
+            n := &ast.ForNode{
+                Id: string(X[1].(*token.Token).Lit),
+                Start: X[3].(ast.Attrib),
+                End: X[5].(ast.Attrib),
+                Body: X[8].([]ast.Attrib),
+                Pos: X[0].(*token.Token).Pos,
+            }
+            // El incremento es nil si se omitió
+            if X[6] != nil {
+                n.Step = X[6].(ast.Attrib)
+            }
+            return n, nil
+        }() >>`,
+		Id:         "Cycle",
+		NTType:     43,
+		Index:      103,
+		NumSymbols: 10,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return func() (Attrib, error) {
+            n := &ast.ForNode{
+                Id: string(X[1].(*token.Token).Lit),
+                Start: X[3].(ast.Attrib),
+                End: X[5].(ast.Attrib),
+                Body: X[8].([]ast.Attrib),
+                Pos: X[0].(*token.Token).Pos,
+            }
+            // El incremento es nil si se omitió
+            if X[6] != nil {
+                n.Step = X[6].(ast.Attrib)
+            }
+            return n, nil
+        }()
+		},
+	},
+	ProdTabEntry{
+		String: `ForStep : step Expression	<< X[1], nil >>`,
+		Id:         "ForStep",
+		NTType:     44,
+		Index:      104,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[1], nil
+		},
+	},
+	ProdTabEntry{
+		String: `ForStep : "empty"	<< nil, nil >>`,
+		Id:         "ForStep",
+		NTType:     44,
+		Index:      105,
+		NumSymbols: 0,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return nil, nil
+		},
+	},
+	ProdTabEntry{
+		String: `LoopJump : break semicolon	<< &ast.BreakNode{
+            Pos: X[0].(*token.Token).Pos,
+        }, nil >>`,
+		Id:         "LoopJump",
+		NTType:     45,
+		Index:      106,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return &ast.BreakNode{
+            Pos: X[0].(*token.Token).Pos,
         }, nil
 		},
 	},
 	ProdTabEntry{
-		String: `F_Call : id lparen F_Args rparen semicolon	<< ast.FCallNode{
+		String: `LoopJump : continue semicolon	<< &ast.ContinueNode{
+            Pos: X[0].(*token.Token).Pos,
+        }, nil >>`,
+		Id:         "LoopJump",
+		NTType:     45,
+		Index:      107,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return &ast.ContinueNode{
+            Pos: X[0].(*token.Token).Pos,
+        }, nil
+		},
+	},
+	ProdTabEntry{
+		String: `F_Call : id lparen F_Args rparen semicolon	<< &ast.FCallNode{
             Id: string(X[0].(*token.Token).Lit),
             Params: X[2].([]ast.Attrib),
+            Pos: X[0].(*token.Token).Pos,
         }, nil >>`,
 		Id:         "F_Call",
-		NTType:     28,
-		Index:      58,
+		NTType:     46,
+		Index:      108,
 		NumSymbols: 5,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return ast.FCallNode{
+			return &ast.FCallNode{
             Id: string(X[0].(*token.Token).Lit),
             Params: X[2].([]ast.Attrib),
+            Pos: X[0].(*token.Token).Pos,
         }, nil
 		},
 	},
 	ProdTabEntry{
-		String: `F_Return : id lparen F_Args rparen	<< ast.FCallNode{
+		String: `F_Return : id lparen F_Args rparen	<< &ast.FCallNode{
             Id: string(X[0].(*token.Token).Lit),
             Params: X[2].([]ast.Attrib),
+            Pos: X[0].(*token.Token).Pos,
         }, nil >>`,
 		Id:         "F_Return",
-		NTType:     29,
-		Index:      59,
+		NTType:     47,
+		Index:      109,
 		NumSymbols: 4,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return ast.FCallNode{
+			return &ast.FCallNode{
             Id: string(X[0].(*token.Token).Lit),
             Params: X[2].([]ast.Attrib),
+            Pos: X[0].(*token.Token).Pos,
         }, nil
 		},
 	},
 	ProdTabEntry{
 		String: `F_Args : F_ArgsList	<< X[0], nil >>`,
 		Id:         "F_Args",
-		NTType:     30,
-		Index:      60,
+		NTType:     48,
+		Index:      110,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -825,8 +1582,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `F_Args : "empty"	<< []ast.Attrib{}, nil >>`,
 		Id:         "F_Args",
-		NTType:     30,
-		Index:      61,
+		NTType:     48,
+		Index:      111,
 		NumSymbols: 0,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return []ast.Attrib{}, nil
@@ -835,8 +1592,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `F_ArgsList : Expression comma F_ArgsList	<< append([]ast.Attrib{ X[0].(ast.Attrib) }, X[2].([]ast.Attrib)..., ), nil >>`,
 		Id:         "F_ArgsList",
-		NTType:     31,
-		Index:      62,
+		NTType:     49,
+		Index:      112,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return append([]ast.Attrib{ X[0].(ast.Attrib) }, X[2].([]ast.Attrib)..., ), nil
@@ -845,32 +1602,34 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `F_ArgsList : Expression	<< []ast.Attrib{ X[0].(ast.Attrib) }, nil >>`,
 		Id:         "F_ArgsList",
-		NTType:     31,
-		Index:      63,
+		NTType:     49,
+		Index:      113,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return []ast.Attrib{ X[0].(ast.Attrib) }, nil
 		},
 	},
 	ProdTabEntry{
-		String: `Print : print lparen PrintVarList rparen semicolon	<< ast.PrintNode{
+		String: `Print : print lparen PrintVarList rparen semicolon	<< &ast.PrintNode{
             Items: X[2].([]ast.Attrib),
+            Pos: X[0].(*token.Token).Pos,
         }, nil >>`,
 		Id:         "Print",
-		NTType:     32,
-		Index:      64,
+		NTType:     50,
+		Index:      114,
 		NumSymbols: 5,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return ast.PrintNode{
+			return &ast.PrintNode{
             Items: X[2].([]ast.Attrib),
+            Pos: X[0].(*token.Token).Pos,
         }, nil
 		},
 	},
 	ProdTabEntry{
 		String: `PrintVarList : PrintVar comma PrintVarList	<< append([]ast.Attrib{ X[0].(ast.Attrib) }, X[2].([]ast.Attrib)..., ), nil >>`,
 		Id:         "PrintVarList",
-		NTType:     33,
-		Index:      65,
+		NTType:     51,
+		Index:      115,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return append([]ast.Attrib{ X[0].(ast.Attrib) }, X[2].([]ast.Attrib)..., ), nil
@@ -879,8 +1638,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `PrintVarList : PrintVar	<< []ast.Attrib{ X[0].(ast.Attrib) }, nil >>`,
 		Id:         "PrintVarList",
-		NTType:     33,
-		Index:      66,
+		NTType:     51,
+		Index:      116,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return []ast.Attrib{ X[0].(ast.Attrib) }, nil
@@ -889,42 +1648,80 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `PrintVar : Expression	<< X[0], nil >>`,
 		Id:         "PrintVar",
-		NTType:     34,
-		Index:      67,
+		NTType:     52,
+		Index:      117,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
 		},
 	},
 	ProdTabEntry{
-		String: `PrintVar : cte_string	<< &ast.VarNode{
-            Type: "string",
-            Value: string(X[0].(*token.Token).Lit),
-        },
-        nil >>`,
-		Id:         "PrintVar",
-		NTType:     34,
-		Index:      68,
+		String: `Read : read lparen ReadVarList rparen semicolon	<< &ast.ReadNode{
+            Vars: X[2].([]*ast.ExpressionVar),
+            Pos: X[0].(*token.Token).Pos,
+        }, nil >>`,
+		Id:         "Read",
+		NTType:     53,
+		Index:      118,
+		NumSymbols: 5,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return &ast.ReadNode{
+            Vars: X[2].([]*ast.ExpressionVar),
+            Pos: X[0].(*token.Token).Pos,
+        }, nil
+		},
+	},
+	ProdTabEntry{
+		String: `ReadVarList : ReadVar comma ReadVarList	<< append([]*ast.ExpressionVar{ X[0].(*ast.ExpressionVar) }, X[2].([]*ast.ExpressionVar)..., ), nil >>`,
+		Id:         "ReadVarList",
+		NTType:     54,
+		Index:      119,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return append([]*ast.ExpressionVar{ X[0].(*ast.ExpressionVar) }, X[2].([]*ast.ExpressionVar)..., ), nil
+		},
+	},
+	ProdTabEntry{
+		String: `ReadVarList : ReadVar	<< []*ast.ExpressionVar{ X[0].(*ast.ExpressionVar) }, nil >>`,
+		Id:         "ReadVarList",
+		NTType:     54,
+		Index:      120,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return &ast.VarNode{
-            Type: "string",
-            Value: string(X[0].(*token.Token).Lit),
-        },
-        nil
+			return []*ast.ExpressionVar{ X[0].(*ast.ExpressionVar) }, nil
+		},
+	},
+	ProdTabEntry{
+		String: `ReadVar : id Indices	<< &ast.ExpressionVar{
+            Id: string(X[0].(*token.Token).Lit),
+            Indices: X[1].([]ast.Attrib),
+            Pos: X[0].(*token.Token).Pos,
+        }, nil >>`,
+		Id:         "ReadVar",
+		NTType:     55,
+		Index:      121,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return &ast.ExpressionVar{
+            Id: string(X[0].(*token.Token).Lit),
+            Indices: X[1].([]ast.Attrib),
+            Pos: X[0].(*token.Token).Pos,
+        }, nil
 		},
 	},
 	ProdTabEntry{
-		String: `Return : return Expression semicolon	<< ast.ReturnNode{
+		String: `Return : return Expression semicolon	<< &ast.ReturnNode{
             Exp: X[1].(ast.Attrib),
+            Pos: X[0].(*token.Token).Pos,
         }, nil >>`,
 		Id:         "Return",
-		NTType:     35,
-		Index:      69,
+		NTType:     56,
+		Index:      122,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return ast.ReturnNode{
+			return &ast.ReturnNode{
             Exp: X[1].(ast.Attrib),
+            Pos: X[0].(*token.Token).Pos,
         }, nil
 		},
 	},
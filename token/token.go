@@ -146,32 +146,56 @@ var TokMap = TokenMap{
 		"var",
 		"empty",
 		"colon",
+		"lbracket",
+		"cte_int",
+		"rbracket",
 		"comma",
 		"int",
 		"float",
+		"bool",
+		"string",
 		"lparen",
 		"rparen",
-		"lbracket",
-		"rbracket",
 		"void",
 		"lbrace",
 		"rbrace",
 		"assign",
+		"or",
+		"or_sym",
+		"and",
+		"and_sym",
+		"not",
+		"not_sym",
 		"gt",
 		"lt",
 		"neq",
+		"eq",
+		"lte",
+		"gte",
 		"plus",
 		"minus",
 		"times",
 		"divide",
-		"cte_int",
+		"mod",
+		"cte_string",
+		"true",
+		"false",
+		"len",
 		"cte_float",
 		"if",
 		"else",
+		"switch",
+		"case",
+		"default",
 		"while",
 		"do",
+		"for",
+		"to",
+		"step",
+		"break",
+		"continue",
 		"print",
-		"cte_string",
+		"read",
 		"return",
 	},
 
@@ -186,32 +210,56 @@ var TokMap = TokenMap{
 		"var":        7,
 		"empty":      8,
 		"colon":      9,
-		"comma":      10,
-		"int":        11,
-		"float":      12,
-		"lparen":     13,
-		"rparen":     14,
-		"lbracket":   15,
-		"rbracket":   16,
-		"void":       17,
-		"lbrace":     18,
-		"rbrace":     19,
-		"assign":     20,
-		"gt":         21,
-		"lt":         22,
-		"neq":        23,
-		"plus":       24,
-		"minus":      25,
-		"times":      26,
-		"divide":     27,
-		"cte_int":    28,
-		"cte_float":  29,
-		"if":         30,
-		"else":       31,
-		"while":      32,
-		"do":         33,
-		"print":      34,
-		"cte_string": 35,
-		"return":     36,
+		"lbracket":   10,
+		"cte_int":    11,
+		"rbracket":   12,
+		"comma":      13,
+		"int":        14,
+		"float":      15,
+		"bool":       16,
+		"string":     17,
+		"lparen":     18,
+		"rparen":     19,
+		"void":       20,
+		"lbrace":     21,
+		"rbrace":     22,
+		"assign":     23,
+		"or":         24,
+		"or_sym":     25,
+		"and":        26,
+		"and_sym":    27,
+		"not":        28,
+		"not_sym":    29,
+		"gt":         30,
+		"lt":         31,
+		"neq":        32,
+		"eq":         33,
+		"lte":        34,
+		"gte":        35,
+		"plus":       36,
+		"minus":      37,
+		"times":      38,
+		"divide":     39,
+		"mod":        40,
+		"cte_string": 41,
+		"true":       42,
+		"false":      43,
+		"len":        44,
+		"cte_float":  45,
+		"if":         46,
+		"else":       47,
+		"switch":     48,
+		"case":       49,
+		"default":    50,
+		"while":      51,
+		"do":         52,
+		"for":        53,
+		"to":         54,
+		"step":       55,
+		"break":      56,
+		"continue":   57,
+		"print":      58,
+		"read":       59,
+		"return":     60,
 	},
 }